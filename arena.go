@@ -0,0 +1,81 @@
+package wren
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+import "unsafe"
+
+// arenaChunkSize is the size of each block of C memory an arena allocates. Strings that are larger get a block of their own
+const arenaChunkSize = 4096
+
+// arena hands out C strings for the duration of a single Interpret or Call (module names, signatures, source code, etc.) from a few large blocks of C memory. Everything allocated after a `mark` is freed in one shot by `release`, so error paths can't forget to free anything
+type arena struct {
+	chunks []arenaChunk
+}
+
+type arenaChunk struct {
+	data       unsafe.Pointer
+	size, used int
+}
+
+type arenaMark struct {
+	chunk, used int
+}
+
+// mark remembers how much of the arena is in use so it can be released later
+func (a *arena) mark() arenaMark {
+	if len(a.chunks) == 0 {
+		return arenaMark{}
+	}
+	last := len(a.chunks) - 1
+	return arenaMark{chunk: last, used: a.chunks[last].used}
+}
+
+// release frees everything that was allocated since `m`. The first block is kept around so the next call doesn't have to allocate again
+func (a *arena) release(m arenaMark) {
+	for i := len(a.chunks) - 1; i > m.chunk; i-- {
+		C.free(a.chunks[i].data)
+	}
+	if m.chunk < len(a.chunks) {
+		a.chunks = a.chunks[:m.chunk+1]
+		kept := &a.chunks[m.chunk]
+		kept.used = m.used
+		if kept.used == 0 && kept.size > arenaChunkSize {
+			C.free(kept.data)
+			a.chunks = a.chunks[:m.chunk]
+		}
+	}
+}
+
+// free releases all of the arena's memory
+func (a *arena) free() {
+	a.release(arenaMark{})
+	for _, chunk := range a.chunks {
+		C.free(chunk.data)
+	}
+	a.chunks = nil
+}
+
+// cString copies `str` into the arena as a null terminated C string
+func (a *arena) cString(str string) *C.char {
+	size := len(str) + 1
+	var chunk *arenaChunk
+	if len(a.chunks) > 0 {
+		chunk = &a.chunks[len(a.chunks)-1]
+	}
+	if chunk == nil || chunk.size-chunk.used < size {
+		chunkSize := arenaChunkSize
+		if size > chunkSize {
+			chunkSize = size
+		}
+		a.chunks = append(a.chunks, arenaChunk{data: C.malloc(C.size_t(chunkSize)), size: chunkSize})
+		chunk = &a.chunks[len(a.chunks)-1]
+	}
+	ptr := unsafe.Pointer(uintptr(chunk.data) + uintptr(chunk.used))
+	buffer := unsafe.Slice((*byte)(ptr), size)
+	copy(buffer, str)
+	buffer[len(str)] = 0
+	chunk.used += size
+	return (*C.char)(ptr)
+}
@@ -0,0 +1,98 @@
+package wren
+
+// Attributes are the attributes of a class or method, by group and then by key. Attributes without a group are under "". A key can be given more than once, so each has a list of its values in the order they were written. Keys without a value have a nil value
+type Attributes map[string]map[string][]interface{}
+
+// Get returns the first value of `key` in `group` ("" for attributes without a group). `ok` is false if the attribute wasn't given
+func (a Attributes) Get(group, key string) (value interface{}, ok bool) {
+	values := a[group][key]
+	if len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+// Has returns whether `key` was given in `group` ("" for attributes without a group)
+func (a Attributes) Has(group, key string) bool {
+	_, ok := a.Get(group, key)
+	return ok
+}
+
+// ClassAttributes are the attributes of a class and its methods
+type ClassAttributes struct {
+	// the attributes of the class itself
+	Self Attributes
+	// the attributes of each method, by signature. Like in Wren, signatures of static and foreign methods start with "static " and "foreign " (such as "foreign static update(_)")
+	Methods map[string]Attributes
+}
+
+// Attributes returns the attributes of the class and its methods. Only attributes that are kept at runtime (written with `#!`, such as `#!route = "/users"`) can be read, since Wren discards the others when compiling. Classes without attributes return empty attributes
+func (h *ClassHandle) Attributes() (*ClassAttributes, error) {
+	attributes := &ClassAttributes{Self: Attributes{}, Methods: map[string]Attributes{}}
+	value, err := callGetter(h.Handle(), "attributes")
+	if err != nil {
+		return nil, err
+	}
+	defer h.VM().FreeAll(value)
+	holder, ok := value.(freeableHandle)
+	if !ok {
+		return attributes, nil
+	}
+	var self map[interface{}]map[string][]interface{}
+	if err := readGetter(holder.Handle(), "self", &self); err != nil {
+		return nil, err
+	}
+	for group, keys := range self {
+		attributes.Self[groupName(group)] = keys
+	}
+	var methods map[string]map[interface{}]map[string][]interface{}
+	if err := readGetter(holder.Handle(), "methods", &methods); err != nil {
+		return nil, err
+	}
+	for signature, groups := range methods {
+		method := Attributes{}
+		for group, keys := range groups {
+			method[groupName(group)] = keys
+		}
+		attributes.Methods[signature] = method
+	}
+	return attributes, nil
+}
+
+// Attributes returns the attributes of the class `class` in `module` and of its methods. See `ClassHandle.Attributes`
+func (vm *VM) Attributes(module, class string) (*ClassAttributes, error) {
+	handle, err := vm.GetClass(module, class)
+	if err != nil {
+		return nil, err
+	}
+	defer handle.Free()
+	return handle.Attributes()
+}
+
+// callGetter calls the getter `name` on `receiver`
+func callGetter(receiver *Handle, name string) (interface{}, error) {
+	fn, err := receiver.Func(name)
+	if err != nil {
+		return nil, err
+	}
+	defer fn.Free()
+	return fn.Call()
+}
+
+// readGetter calls the getter `name` on `receiver` and unmarshals what it returns into `out`. If it returns null, such as `methods` of a class whose methods don't have attributes, `out` is left as it is
+func readGetter(receiver *Handle, name string, out interface{}) error {
+	value, err := callGetter(receiver, name)
+	if err != nil || value == nil || value == Null {
+		return err
+	}
+	defer receiver.VM().FreeAll(value)
+	return receiver.VM().Unmarshal(value, out)
+}
+
+// groupName is the name of an attribute group. Wren keeps attributes without a group under null
+func groupName(group interface{}) string {
+	if name, ok := group.(string); ok {
+		return name
+	}
+	return ""
+}
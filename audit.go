@@ -0,0 +1,140 @@
+package wren
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// AuditKind is the kind of action an `AuditEvent` records
+type AuditKind string
+
+const (
+	// AuditImport is recorded whenever a script imports a module
+	AuditImport AuditKind = "import"
+	// AuditForeignCall is recorded whenever a script calls a foreign method or foreign constructor
+	AuditForeignCall AuditKind = "call"
+)
+
+// auditArgLimit is how long an argument in an `AuditEvent` can be before it is truncated
+const auditArgLimit = 64
+
+// AuditEvent records something a script did
+type AuditEvent struct {
+	Time time.Time `json:"time"`
+	// `Config.Name` of the VM the script ran in
+	VM   string    `json:"vm,omitempty"`
+	Kind AuditKind `json:"kind"`
+	// For imports, the module doing the importing
+	Importer string `json:"importer,omitempty"`
+	// For imports, the resolved name of the imported module. For foreign calls, the module the foreign class is in
+	Module string `json:"module"`
+	// For imports, false if the module name could not be resolved
+	Resolved bool   `json:"resolved,omitempty"`
+	Class    string `json:"class,omitempty"`
+	// Signature of the foreign method ("<allocate>" for foreign constructors)
+	Signature string `json:"signature,omitempty"`
+	// Arguments passed to the foreign method, converted to strings and truncated
+	Args []string `json:"args,omitempty"`
+}
+
+// AuditSink receives `AuditEvent`s from every VM whose config sets `Config.AuditSink`. It may be called from multiple VMs at once
+type AuditSink interface {
+	Audit(event AuditEvent)
+}
+
+// AuditFunc lets a function be used as an `AuditSink`
+type AuditFunc func(event AuditEvent)
+
+// Audit calls the function
+func (fn AuditFunc) Audit(event AuditEvent) {
+	fn(event)
+}
+
+type jsonAuditSink struct {
+	mux     sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONAuditSink creates an `AuditSink` that writes every event to `w` as a line of JSON
+func NewJSONAuditSink(w io.Writer) AuditSink {
+	return &jsonAuditSink{encoder: json.NewEncoder(w)}
+}
+
+func (sink *jsonAuditSink) Audit(event AuditEvent) {
+	sink.mux.Lock()
+	defer sink.mux.Unlock()
+	sink.encoder.Encode(event)
+}
+
+func (vm *VM) auditSink() AuditSink {
+	if vm.Config == nil {
+		return nil
+	}
+	return vm.Config.AuditSink
+}
+
+func (vm *VM) vmName() string {
+	if vm.Config == nil {
+		return ""
+	}
+	return vm.Config.Name
+}
+
+func (vm *VM) auditImport(importer, module string, resolved bool) {
+	if sink := vm.auditSink(); sink != nil {
+		sink.Audit(AuditEvent{Time: time.Now(), VM: vm.vmName(), Kind: AuditImport, Importer: importer, Module: module, Resolved: resolved})
+	}
+}
+
+// auditMethod wraps a foreign method so that calls to it are recorded by the config's `AuditSink`. If there is no sink, `fn` is returned as is
+func (vm *VM) auditMethod(module, class, signature string, fn ForeignMethodFn) ForeignMethodFn {
+	sink := vm.auditSink()
+	if sink == nil {
+		return fn
+	}
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		args := make([]string, 0, len(parameters))
+		if len(parameters) > 1 {
+			for _, param := range parameters[1:] {
+				args = append(args, auditString(param))
+			}
+		}
+		sink.Audit(AuditEvent{Time: time.Now(), VM: vm.vmName(), Kind: AuditForeignCall, Module: module, Class: class, Signature: signature, Args: args})
+		return fn(vm, parameters)
+	}
+}
+
+func auditString(value interface{}) string {
+	var str string
+	switch value.(type) {
+	case *ListHandle:
+		str = "<list>"
+	case *MapHandle:
+		str = "<map>"
+	case *ForeignHandle:
+		str = "<foreign>"
+	case *FiberHandle:
+		str = "<fiber>"
+	case *ClassHandle:
+		str = "<class>"
+	case *FnHandle:
+		str = "<fn>"
+	case *RangeHandle:
+		str = "<range>"
+	case *Handle:
+		str = "<object>"
+	case string:
+		str = fmt.Sprintf("%q", value)
+	case nil:
+		str = "null"
+	default:
+		str = fmt.Sprint(value)
+	}
+	if len(str) > auditArgLimit {
+		str = str[:auditArgLimit] + "..."
+	}
+	return str
+}
@@ -0,0 +1,166 @@
+package wren
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"unicode"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// BindStruct creates a `ForeignClass` for a Go struct type. `prototype` can be a struct, a pointer to a struct, or the `reflect.Type` of either (see `Bind` to name the type instead).
+//
+// Instances are stored as pointers to the struct. The constructor sets exported fields in the order they are declared from its arguments (so `construct new(x, y) {}` sets the first two fields). Every exported field gets a getter and setter and every exported method of the pointer type is bound with one parameter for each of its arguments. Names start with a lowercase letter in Wren, so the field `Name` becomes `name` and `name=(_)` and the method `MoveBy(dx, dy float64)` becomes `moveBy(_,_)`.
+//
+// Arguments are converted with `Unmarshal` and results with `Marshal`, so structs are returned to Wren as maps. Methods may return one value, an error, or a value and an error. Variadic methods and methods with other results are not bound. Because Wren maps can't be read while the VM is running, maps can't be passed as arguments
+func BindStruct(prototype interface{}) (*ForeignClass, error) {
+	t, ok := prototype.(reflect.Type)
+	if !ok {
+		t = reflect.TypeOf(prototype)
+	}
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("BindStruct expects a struct type but got %v", t)
+	}
+	fields := structFields(t)
+	methods := make(MethodMap)
+	for _, field := range fields {
+		field := field
+		name := wrenName(t.Field(field.index).Name)
+		methods[name] = func(vm *VM, parameters []interface{}) (interface{}, error) {
+			receiver, err := boundReceiver(t, parameters)
+			if err != nil {
+				return nil, err
+			}
+			return vm.returnValue(receiver.Field(field.index))
+		}
+		methods[name+"=(_)"] = func(vm *VM, parameters []interface{}) (interface{}, error) {
+			receiver, err := boundReceiver(t, parameters)
+			if err != nil {
+				return nil, err
+			}
+			if err := vm.unmarshal(parameters[1], receiver.Field(field.index), t.Field(field.index).Name); err != nil {
+				return nil, err
+			}
+			return parameters[1], nil
+		}
+	}
+	pointer := reflect.PtrTo(t)
+	for i := 0; i < pointer.NumMethod(); i++ {
+		method := pointer.Method(i)
+		fn := method.Type
+		if fn.IsVariadic() || fn.NumOut() > 2 || fn.NumOut() == 2 && fn.Out(1) != errorType {
+			continue
+		}
+		arity := fn.NumIn() - 1
+		signature := methodSignature(wrenName(method.Name), arity)
+		methods[signature] = func(vm *VM, parameters []interface{}) (interface{}, error) {
+			receiver, err := boundReceiver(t, parameters)
+			if err != nil {
+				return nil, err
+			}
+			if len(parameters)-1 != arity {
+				return nil, fmt.Errorf("%v expects %v arguments but got %v", method.Name, arity, len(parameters)-1)
+			}
+			args := make([]reflect.Value, arity+1)
+			args[0] = receiver.Addr()
+			for i := 1; i <= arity; i++ {
+				args[i] = reflect.New(fn.In(i)).Elem()
+				if err := vm.unmarshal(parameters[i], args[i], fmt.Sprintf("%v argument %v", method.Name, i)); err != nil {
+					return nil, err
+				}
+			}
+			results := method.Func.Call(args)
+			if len(results) > 0 && fn.Out(len(results)-1) == errorType {
+				if err, _ := results[len(results)-1].Interface().(error); err != nil {
+					return nil, err
+				}
+				results = results[:len(results)-1]
+			}
+			if len(results) == 0 {
+				return Null, nil
+			}
+			return vm.returnValue(results[0])
+		}
+	}
+	initializer := func(vm *VM, parameters []interface{}) (interface{}, error) {
+		instance := reflect.New(t)
+		if len(parameters)-1 > len(fields) {
+			return nil, fmt.Errorf("%v has %v fields but the constructor was given %v arguments", t.Name(), len(fields), len(parameters)-1)
+		}
+		for i, parameter := range parameters[1:] {
+			field := fields[i]
+			if err := vm.unmarshal(parameter, instance.Elem().Field(field.index), t.Field(field.index).Name); err != nil {
+				return nil, err
+			}
+		}
+		return instance.Interface(), nil
+	}
+	return NewClass(initializer, nil, methods), nil
+}
+
+// Bind is like `BindStruct` but takes the struct type as a type parameter, such as `Bind[Point]()`
+func Bind[T any]() (*ForeignClass, error) {
+	return BindStruct(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// boundReceiver gets the struct that a method bound by `BindStruct` was called on
+func boundReceiver(t reflect.Type, parameters []interface{}) (reflect.Value, error) {
+	if len(parameters) > 0 {
+		if foreign, ok := parameters[0].(*ForeignHandle); ok {
+			value, err := foreign.Get()
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			if v := reflect.ValueOf(value); v.Type() == reflect.PtrTo(t) && !v.IsNil() {
+				return v.Elem(), nil
+			}
+		}
+	}
+	return reflect.Value{}, errors.New("receiver is not a " + t.Name())
+}
+
+// Return converts `value` with `Marshal` and stores it as the result of the foreign method that is running, freeing any handles it creates. A `ForeignMethodFn` can end with `return vm.Return(value)` to give Wren lists and maps built from Go values without leaking their handles
+func (vm *VM) Return(value interface{}) (interface{}, error) {
+	return vm.returnValue(reflect.ValueOf(value))
+}
+
+// returnValue marshals a value returned to Wren from a foreign method. Lists and maps created for it are put into the return slot directly so their handles can be freed right away
+func (vm *VM) returnValue(v reflect.Value) (interface{}, error) {
+	value, err := vm.marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	switch value.(type) {
+	case nil:
+		return Null, nil
+	case *Handle, *ListHandle, *MapHandle, *ForeignHandle, *FiberHandle, *ClassHandle, *FnHandle, *RangeHandle:
+		defer vm.FreeAll(value)
+		if err := vm.setSlotValue(value, 0); err != nil {
+			return nil, err
+		}
+		// the value is already in the return slot
+		return nil, nil
+	}
+	return value, nil
+}
+
+// wrenName converts an exported Go name into the lower camel case Wren uses, such as "MoveBy" into "moveBy" and "URLPath" into "urlPath"
+func wrenName(name string) string {
+	runes := []rune(name)
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	if upper > 1 && upper < len(runes) {
+		// keep the start of the next word in an acronym like "URLPath"
+		upper--
+	}
+	for i := 0; i < upper; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
@@ -0,0 +1,1175 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+
+extern void f0(WrenVM* vm);
+extern void f1(WrenVM* vm);
+extern void f2(WrenVM* vm);
+extern void f3(WrenVM* vm);
+extern void f4(WrenVM* vm);
+extern void f5(WrenVM* vm);
+extern void f6(WrenVM* vm);
+extern void f7(WrenVM* vm);
+extern void f8(WrenVM* vm);
+extern void f9(WrenVM* vm);
+extern void f10(WrenVM* vm);
+extern void f11(WrenVM* vm);
+extern void f12(WrenVM* vm);
+extern void f13(WrenVM* vm);
+extern void f14(WrenVM* vm);
+extern void f15(WrenVM* vm);
+extern void f16(WrenVM* vm);
+extern void f17(WrenVM* vm);
+extern void f18(WrenVM* vm);
+extern void f19(WrenVM* vm);
+extern void f20(WrenVM* vm);
+extern void f21(WrenVM* vm);
+extern void f22(WrenVM* vm);
+extern void f23(WrenVM* vm);
+extern void f24(WrenVM* vm);
+extern void f25(WrenVM* vm);
+extern void f26(WrenVM* vm);
+extern void f27(WrenVM* vm);
+extern void f28(WrenVM* vm);
+extern void f29(WrenVM* vm);
+extern void f30(WrenVM* vm);
+extern void f31(WrenVM* vm);
+extern void f32(WrenVM* vm);
+extern void f33(WrenVM* vm);
+extern void f34(WrenVM* vm);
+extern void f35(WrenVM* vm);
+extern void f36(WrenVM* vm);
+extern void f37(WrenVM* vm);
+extern void f38(WrenVM* vm);
+extern void f39(WrenVM* vm);
+extern void f40(WrenVM* vm);
+extern void f41(WrenVM* vm);
+extern void f42(WrenVM* vm);
+extern void f43(WrenVM* vm);
+extern void f44(WrenVM* vm);
+extern void f45(WrenVM* vm);
+extern void f46(WrenVM* vm);
+extern void f47(WrenVM* vm);
+extern void f48(WrenVM* vm);
+extern void f49(WrenVM* vm);
+extern void f50(WrenVM* vm);
+extern void f51(WrenVM* vm);
+extern void f52(WrenVM* vm);
+extern void f53(WrenVM* vm);
+extern void f54(WrenVM* vm);
+extern void f55(WrenVM* vm);
+extern void f56(WrenVM* vm);
+extern void f57(WrenVM* vm);
+extern void f58(WrenVM* vm);
+extern void f59(WrenVM* vm);
+extern void f60(WrenVM* vm);
+extern void f61(WrenVM* vm);
+extern void f62(WrenVM* vm);
+extern void f63(WrenVM* vm);
+extern void f64(WrenVM* vm);
+extern void f65(WrenVM* vm);
+extern void f66(WrenVM* vm);
+extern void f67(WrenVM* vm);
+extern void f68(WrenVM* vm);
+extern void f69(WrenVM* vm);
+extern void f70(WrenVM* vm);
+extern void f71(WrenVM* vm);
+extern void f72(WrenVM* vm);
+extern void f73(WrenVM* vm);
+extern void f74(WrenVM* vm);
+extern void f75(WrenVM* vm);
+extern void f76(WrenVM* vm);
+extern void f77(WrenVM* vm);
+extern void f78(WrenVM* vm);
+extern void f79(WrenVM* vm);
+extern void f80(WrenVM* vm);
+extern void f81(WrenVM* vm);
+extern void f82(WrenVM* vm);
+extern void f83(WrenVM* vm);
+extern void f84(WrenVM* vm);
+extern void f85(WrenVM* vm);
+extern void f86(WrenVM* vm);
+extern void f87(WrenVM* vm);
+extern void f88(WrenVM* vm);
+extern void f89(WrenVM* vm);
+extern void f90(WrenVM* vm);
+extern void f91(WrenVM* vm);
+extern void f92(WrenVM* vm);
+extern void f93(WrenVM* vm);
+extern void f94(WrenVM* vm);
+extern void f95(WrenVM* vm);
+extern void f96(WrenVM* vm);
+extern void f97(WrenVM* vm);
+extern void f98(WrenVM* vm);
+extern void f99(WrenVM* vm);
+extern void f100(WrenVM* vm);
+extern void f101(WrenVM* vm);
+extern void f102(WrenVM* vm);
+extern void f103(WrenVM* vm);
+extern void f104(WrenVM* vm);
+extern void f105(WrenVM* vm);
+extern void f106(WrenVM* vm);
+extern void f107(WrenVM* vm);
+extern void f108(WrenVM* vm);
+extern void f109(WrenVM* vm);
+extern void f110(WrenVM* vm);
+extern void f111(WrenVM* vm);
+extern void f112(WrenVM* vm);
+extern void f113(WrenVM* vm);
+extern void f114(WrenVM* vm);
+extern void f115(WrenVM* vm);
+extern void f116(WrenVM* vm);
+extern void f117(WrenVM* vm);
+extern void f118(WrenVM* vm);
+extern void f119(WrenVM* vm);
+extern void f120(WrenVM* vm);
+extern void f121(WrenVM* vm);
+extern void f122(WrenVM* vm);
+extern void f123(WrenVM* vm);
+extern void f124(WrenVM* vm);
+extern void f125(WrenVM* vm);
+extern void f126(WrenVM* vm);
+extern void f127(WrenVM* vm);
+
+static inline WrenForeignMethodFn get_f0(int i) {
+	switch (i) {
+		case 0: return f0;
+		case 1: return f1;
+		case 2: return f2;
+		case 3: return f3;
+		case 4: return f4;
+		case 5: return f5;
+		case 6: return f6;
+		case 7: return f7;
+		case 8: return f8;
+		case 9: return f9;
+		case 10: return f10;
+		case 11: return f11;
+		case 12: return f12;
+		case 13: return f13;
+		case 14: return f14;
+		case 15: return f15;
+		case 16: return f16;
+		case 17: return f17;
+		case 18: return f18;
+		case 19: return f19;
+		case 20: return f20;
+		case 21: return f21;
+		case 22: return f22;
+		case 23: return f23;
+		case 24: return f24;
+		case 25: return f25;
+		case 26: return f26;
+		case 27: return f27;
+		case 28: return f28;
+		case 29: return f29;
+		case 30: return f30;
+		case 31: return f31;
+		case 32: return f32;
+		case 33: return f33;
+		case 34: return f34;
+		case 35: return f35;
+		case 36: return f36;
+		case 37: return f37;
+		case 38: return f38;
+		case 39: return f39;
+		case 40: return f40;
+		case 41: return f41;
+		case 42: return f42;
+		case 43: return f43;
+		case 44: return f44;
+		case 45: return f45;
+		case 46: return f46;
+		case 47: return f47;
+		case 48: return f48;
+		case 49: return f49;
+		case 50: return f50;
+		case 51: return f51;
+		case 52: return f52;
+		case 53: return f53;
+		case 54: return f54;
+		case 55: return f55;
+		case 56: return f56;
+		case 57: return f57;
+		case 58: return f58;
+		case 59: return f59;
+		case 60: return f60;
+		case 61: return f61;
+		case 62: return f62;
+		case 63: return f63;
+		case 64: return f64;
+		case 65: return f65;
+		case 66: return f66;
+		case 67: return f67;
+		case 68: return f68;
+		case 69: return f69;
+		case 70: return f70;
+		case 71: return f71;
+		case 72: return f72;
+		case 73: return f73;
+		case 74: return f74;
+		case 75: return f75;
+		case 76: return f76;
+		case 77: return f77;
+		case 78: return f78;
+		case 79: return f79;
+		case 80: return f80;
+		case 81: return f81;
+		case 82: return f82;
+		case 83: return f83;
+		case 84: return f84;
+		case 85: return f85;
+		case 86: return f86;
+		case 87: return f87;
+		case 88: return f88;
+		case 89: return f89;
+		case 90: return f90;
+		case 91: return f91;
+		case 92: return f92;
+		case 93: return f93;
+		case 94: return f94;
+		case 95: return f95;
+		case 96: return f96;
+		case 97: return f97;
+		case 98: return f98;
+		case 99: return f99;
+		case 100: return f100;
+		case 101: return f101;
+		case 102: return f102;
+		case 103: return f103;
+		case 104: return f104;
+		case 105: return f105;
+		case 106: return f106;
+		case 107: return f107;
+		case 108: return f108;
+		case 109: return f109;
+		case 110: return f110;
+		case 111: return f111;
+		case 112: return f112;
+		case 113: return f113;
+		case 114: return f114;
+		case 115: return f115;
+		case 116: return f116;
+		case 117: return f117;
+		case 118: return f118;
+		case 119: return f119;
+		case 120: return f120;
+		case 121: return f121;
+		case 122: return f122;
+		case 123: return f123;
+		case 124: return f124;
+		case 125: return f125;
+		case 126: return f126;
+		case 127: return f127;
+		default: return (void*)(0);
+	}
+}
+*/
+import "C"
+
+// bindingChunk0 returns the trampoline for the binding at `index`
+func bindingChunk0(index int) C.WrenForeignMethodFn {
+	return C.get_f0(C.int(index))
+}
+
+//export f0
+func f0(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(0)
+	}
+}
+
+//export f1
+func f1(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(1)
+	}
+}
+
+//export f2
+func f2(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(2)
+	}
+}
+
+//export f3
+func f3(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(3)
+	}
+}
+
+//export f4
+func f4(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(4)
+	}
+}
+
+//export f5
+func f5(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(5)
+	}
+}
+
+//export f6
+func f6(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(6)
+	}
+}
+
+//export f7
+func f7(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(7)
+	}
+}
+
+//export f8
+func f8(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(8)
+	}
+}
+
+//export f9
+func f9(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(9)
+	}
+}
+
+//export f10
+func f10(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(10)
+	}
+}
+
+//export f11
+func f11(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(11)
+	}
+}
+
+//export f12
+func f12(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(12)
+	}
+}
+
+//export f13
+func f13(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(13)
+	}
+}
+
+//export f14
+func f14(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(14)
+	}
+}
+
+//export f15
+func f15(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(15)
+	}
+}
+
+//export f16
+func f16(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(16)
+	}
+}
+
+//export f17
+func f17(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(17)
+	}
+}
+
+//export f18
+func f18(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(18)
+	}
+}
+
+//export f19
+func f19(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(19)
+	}
+}
+
+//export f20
+func f20(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(20)
+	}
+}
+
+//export f21
+func f21(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(21)
+	}
+}
+
+//export f22
+func f22(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(22)
+	}
+}
+
+//export f23
+func f23(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(23)
+	}
+}
+
+//export f24
+func f24(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(24)
+	}
+}
+
+//export f25
+func f25(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(25)
+	}
+}
+
+//export f26
+func f26(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(26)
+	}
+}
+
+//export f27
+func f27(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(27)
+	}
+}
+
+//export f28
+func f28(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(28)
+	}
+}
+
+//export f29
+func f29(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(29)
+	}
+}
+
+//export f30
+func f30(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(30)
+	}
+}
+
+//export f31
+func f31(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(31)
+	}
+}
+
+//export f32
+func f32(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(32)
+	}
+}
+
+//export f33
+func f33(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(33)
+	}
+}
+
+//export f34
+func f34(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(34)
+	}
+}
+
+//export f35
+func f35(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(35)
+	}
+}
+
+//export f36
+func f36(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(36)
+	}
+}
+
+//export f37
+func f37(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(37)
+	}
+}
+
+//export f38
+func f38(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(38)
+	}
+}
+
+//export f39
+func f39(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(39)
+	}
+}
+
+//export f40
+func f40(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(40)
+	}
+}
+
+//export f41
+func f41(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(41)
+	}
+}
+
+//export f42
+func f42(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(42)
+	}
+}
+
+//export f43
+func f43(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(43)
+	}
+}
+
+//export f44
+func f44(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(44)
+	}
+}
+
+//export f45
+func f45(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(45)
+	}
+}
+
+//export f46
+func f46(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(46)
+	}
+}
+
+//export f47
+func f47(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(47)
+	}
+}
+
+//export f48
+func f48(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(48)
+	}
+}
+
+//export f49
+func f49(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(49)
+	}
+}
+
+//export f50
+func f50(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(50)
+	}
+}
+
+//export f51
+func f51(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(51)
+	}
+}
+
+//export f52
+func f52(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(52)
+	}
+}
+
+//export f53
+func f53(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(53)
+	}
+}
+
+//export f54
+func f54(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(54)
+	}
+}
+
+//export f55
+func f55(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(55)
+	}
+}
+
+//export f56
+func f56(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(56)
+	}
+}
+
+//export f57
+func f57(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(57)
+	}
+}
+
+//export f58
+func f58(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(58)
+	}
+}
+
+//export f59
+func f59(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(59)
+	}
+}
+
+//export f60
+func f60(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(60)
+	}
+}
+
+//export f61
+func f61(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(61)
+	}
+}
+
+//export f62
+func f62(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(62)
+	}
+}
+
+//export f63
+func f63(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(63)
+	}
+}
+
+//export f64
+func f64(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(64)
+	}
+}
+
+//export f65
+func f65(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(65)
+	}
+}
+
+//export f66
+func f66(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(66)
+	}
+}
+
+//export f67
+func f67(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(67)
+	}
+}
+
+//export f68
+func f68(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(68)
+	}
+}
+
+//export f69
+func f69(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(69)
+	}
+}
+
+//export f70
+func f70(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(70)
+	}
+}
+
+//export f71
+func f71(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(71)
+	}
+}
+
+//export f72
+func f72(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(72)
+	}
+}
+
+//export f73
+func f73(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(73)
+	}
+}
+
+//export f74
+func f74(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(74)
+	}
+}
+
+//export f75
+func f75(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(75)
+	}
+}
+
+//export f76
+func f76(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(76)
+	}
+}
+
+//export f77
+func f77(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(77)
+	}
+}
+
+//export f78
+func f78(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(78)
+	}
+}
+
+//export f79
+func f79(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(79)
+	}
+}
+
+//export f80
+func f80(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(80)
+	}
+}
+
+//export f81
+func f81(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(81)
+	}
+}
+
+//export f82
+func f82(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(82)
+	}
+}
+
+//export f83
+func f83(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(83)
+	}
+}
+
+//export f84
+func f84(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(84)
+	}
+}
+
+//export f85
+func f85(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(85)
+	}
+}
+
+//export f86
+func f86(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(86)
+	}
+}
+
+//export f87
+func f87(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(87)
+	}
+}
+
+//export f88
+func f88(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(88)
+	}
+}
+
+//export f89
+func f89(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(89)
+	}
+}
+
+//export f90
+func f90(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(90)
+	}
+}
+
+//export f91
+func f91(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(91)
+	}
+}
+
+//export f92
+func f92(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(92)
+	}
+}
+
+//export f93
+func f93(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(93)
+	}
+}
+
+//export f94
+func f94(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(94)
+	}
+}
+
+//export f95
+func f95(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(95)
+	}
+}
+
+//export f96
+func f96(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(96)
+	}
+}
+
+//export f97
+func f97(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(97)
+	}
+}
+
+//export f98
+func f98(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(98)
+	}
+}
+
+//export f99
+func f99(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(99)
+	}
+}
+
+//export f100
+func f100(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(100)
+	}
+}
+
+//export f101
+func f101(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(101)
+	}
+}
+
+//export f102
+func f102(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(102)
+	}
+}
+
+//export f103
+func f103(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(103)
+	}
+}
+
+//export f104
+func f104(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(104)
+	}
+}
+
+//export f105
+func f105(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(105)
+	}
+}
+
+//export f106
+func f106(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(106)
+	}
+}
+
+//export f107
+func f107(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(107)
+	}
+}
+
+//export f108
+func f108(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(108)
+	}
+}
+
+//export f109
+func f109(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(109)
+	}
+}
+
+//export f110
+func f110(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(110)
+	}
+}
+
+//export f111
+func f111(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(111)
+	}
+}
+
+//export f112
+func f112(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(112)
+	}
+}
+
+//export f113
+func f113(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(113)
+	}
+}
+
+//export f114
+func f114(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(114)
+	}
+}
+
+//export f115
+func f115(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(115)
+	}
+}
+
+//export f116
+func f116(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(116)
+	}
+}
+
+//export f117
+func f117(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(117)
+	}
+}
+
+//export f118
+func f118(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(118)
+	}
+}
+
+//export f119
+func f119(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(119)
+	}
+}
+
+//export f120
+func f120(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(120)
+	}
+}
+
+//export f121
+func f121(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(121)
+	}
+}
+
+//export f122
+func f122(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(122)
+	}
+}
+
+//export f123
+func f123(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(123)
+	}
+}
+
+//export f124
+func f124(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(124)
+	}
+}
+
+//export f125
+func f125(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(125)
+	}
+}
+
+//export f126
+func f126(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(126)
+	}
+}
+
+//export f127
+func f127(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(127)
+	}
+}
+
@@ -0,0 +1,1175 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+
+extern void f128(WrenVM* vm);
+extern void f129(WrenVM* vm);
+extern void f130(WrenVM* vm);
+extern void f131(WrenVM* vm);
+extern void f132(WrenVM* vm);
+extern void f133(WrenVM* vm);
+extern void f134(WrenVM* vm);
+extern void f135(WrenVM* vm);
+extern void f136(WrenVM* vm);
+extern void f137(WrenVM* vm);
+extern void f138(WrenVM* vm);
+extern void f139(WrenVM* vm);
+extern void f140(WrenVM* vm);
+extern void f141(WrenVM* vm);
+extern void f142(WrenVM* vm);
+extern void f143(WrenVM* vm);
+extern void f144(WrenVM* vm);
+extern void f145(WrenVM* vm);
+extern void f146(WrenVM* vm);
+extern void f147(WrenVM* vm);
+extern void f148(WrenVM* vm);
+extern void f149(WrenVM* vm);
+extern void f150(WrenVM* vm);
+extern void f151(WrenVM* vm);
+extern void f152(WrenVM* vm);
+extern void f153(WrenVM* vm);
+extern void f154(WrenVM* vm);
+extern void f155(WrenVM* vm);
+extern void f156(WrenVM* vm);
+extern void f157(WrenVM* vm);
+extern void f158(WrenVM* vm);
+extern void f159(WrenVM* vm);
+extern void f160(WrenVM* vm);
+extern void f161(WrenVM* vm);
+extern void f162(WrenVM* vm);
+extern void f163(WrenVM* vm);
+extern void f164(WrenVM* vm);
+extern void f165(WrenVM* vm);
+extern void f166(WrenVM* vm);
+extern void f167(WrenVM* vm);
+extern void f168(WrenVM* vm);
+extern void f169(WrenVM* vm);
+extern void f170(WrenVM* vm);
+extern void f171(WrenVM* vm);
+extern void f172(WrenVM* vm);
+extern void f173(WrenVM* vm);
+extern void f174(WrenVM* vm);
+extern void f175(WrenVM* vm);
+extern void f176(WrenVM* vm);
+extern void f177(WrenVM* vm);
+extern void f178(WrenVM* vm);
+extern void f179(WrenVM* vm);
+extern void f180(WrenVM* vm);
+extern void f181(WrenVM* vm);
+extern void f182(WrenVM* vm);
+extern void f183(WrenVM* vm);
+extern void f184(WrenVM* vm);
+extern void f185(WrenVM* vm);
+extern void f186(WrenVM* vm);
+extern void f187(WrenVM* vm);
+extern void f188(WrenVM* vm);
+extern void f189(WrenVM* vm);
+extern void f190(WrenVM* vm);
+extern void f191(WrenVM* vm);
+extern void f192(WrenVM* vm);
+extern void f193(WrenVM* vm);
+extern void f194(WrenVM* vm);
+extern void f195(WrenVM* vm);
+extern void f196(WrenVM* vm);
+extern void f197(WrenVM* vm);
+extern void f198(WrenVM* vm);
+extern void f199(WrenVM* vm);
+extern void f200(WrenVM* vm);
+extern void f201(WrenVM* vm);
+extern void f202(WrenVM* vm);
+extern void f203(WrenVM* vm);
+extern void f204(WrenVM* vm);
+extern void f205(WrenVM* vm);
+extern void f206(WrenVM* vm);
+extern void f207(WrenVM* vm);
+extern void f208(WrenVM* vm);
+extern void f209(WrenVM* vm);
+extern void f210(WrenVM* vm);
+extern void f211(WrenVM* vm);
+extern void f212(WrenVM* vm);
+extern void f213(WrenVM* vm);
+extern void f214(WrenVM* vm);
+extern void f215(WrenVM* vm);
+extern void f216(WrenVM* vm);
+extern void f217(WrenVM* vm);
+extern void f218(WrenVM* vm);
+extern void f219(WrenVM* vm);
+extern void f220(WrenVM* vm);
+extern void f221(WrenVM* vm);
+extern void f222(WrenVM* vm);
+extern void f223(WrenVM* vm);
+extern void f224(WrenVM* vm);
+extern void f225(WrenVM* vm);
+extern void f226(WrenVM* vm);
+extern void f227(WrenVM* vm);
+extern void f228(WrenVM* vm);
+extern void f229(WrenVM* vm);
+extern void f230(WrenVM* vm);
+extern void f231(WrenVM* vm);
+extern void f232(WrenVM* vm);
+extern void f233(WrenVM* vm);
+extern void f234(WrenVM* vm);
+extern void f235(WrenVM* vm);
+extern void f236(WrenVM* vm);
+extern void f237(WrenVM* vm);
+extern void f238(WrenVM* vm);
+extern void f239(WrenVM* vm);
+extern void f240(WrenVM* vm);
+extern void f241(WrenVM* vm);
+extern void f242(WrenVM* vm);
+extern void f243(WrenVM* vm);
+extern void f244(WrenVM* vm);
+extern void f245(WrenVM* vm);
+extern void f246(WrenVM* vm);
+extern void f247(WrenVM* vm);
+extern void f248(WrenVM* vm);
+extern void f249(WrenVM* vm);
+extern void f250(WrenVM* vm);
+extern void f251(WrenVM* vm);
+extern void f252(WrenVM* vm);
+extern void f253(WrenVM* vm);
+extern void f254(WrenVM* vm);
+extern void f255(WrenVM* vm);
+
+static inline WrenForeignMethodFn get_f1(int i) {
+	switch (i) {
+		case 128: return f128;
+		case 129: return f129;
+		case 130: return f130;
+		case 131: return f131;
+		case 132: return f132;
+		case 133: return f133;
+		case 134: return f134;
+		case 135: return f135;
+		case 136: return f136;
+		case 137: return f137;
+		case 138: return f138;
+		case 139: return f139;
+		case 140: return f140;
+		case 141: return f141;
+		case 142: return f142;
+		case 143: return f143;
+		case 144: return f144;
+		case 145: return f145;
+		case 146: return f146;
+		case 147: return f147;
+		case 148: return f148;
+		case 149: return f149;
+		case 150: return f150;
+		case 151: return f151;
+		case 152: return f152;
+		case 153: return f153;
+		case 154: return f154;
+		case 155: return f155;
+		case 156: return f156;
+		case 157: return f157;
+		case 158: return f158;
+		case 159: return f159;
+		case 160: return f160;
+		case 161: return f161;
+		case 162: return f162;
+		case 163: return f163;
+		case 164: return f164;
+		case 165: return f165;
+		case 166: return f166;
+		case 167: return f167;
+		case 168: return f168;
+		case 169: return f169;
+		case 170: return f170;
+		case 171: return f171;
+		case 172: return f172;
+		case 173: return f173;
+		case 174: return f174;
+		case 175: return f175;
+		case 176: return f176;
+		case 177: return f177;
+		case 178: return f178;
+		case 179: return f179;
+		case 180: return f180;
+		case 181: return f181;
+		case 182: return f182;
+		case 183: return f183;
+		case 184: return f184;
+		case 185: return f185;
+		case 186: return f186;
+		case 187: return f187;
+		case 188: return f188;
+		case 189: return f189;
+		case 190: return f190;
+		case 191: return f191;
+		case 192: return f192;
+		case 193: return f193;
+		case 194: return f194;
+		case 195: return f195;
+		case 196: return f196;
+		case 197: return f197;
+		case 198: return f198;
+		case 199: return f199;
+		case 200: return f200;
+		case 201: return f201;
+		case 202: return f202;
+		case 203: return f203;
+		case 204: return f204;
+		case 205: return f205;
+		case 206: return f206;
+		case 207: return f207;
+		case 208: return f208;
+		case 209: return f209;
+		case 210: return f210;
+		case 211: return f211;
+		case 212: return f212;
+		case 213: return f213;
+		case 214: return f214;
+		case 215: return f215;
+		case 216: return f216;
+		case 217: return f217;
+		case 218: return f218;
+		case 219: return f219;
+		case 220: return f220;
+		case 221: return f221;
+		case 222: return f222;
+		case 223: return f223;
+		case 224: return f224;
+		case 225: return f225;
+		case 226: return f226;
+		case 227: return f227;
+		case 228: return f228;
+		case 229: return f229;
+		case 230: return f230;
+		case 231: return f231;
+		case 232: return f232;
+		case 233: return f233;
+		case 234: return f234;
+		case 235: return f235;
+		case 236: return f236;
+		case 237: return f237;
+		case 238: return f238;
+		case 239: return f239;
+		case 240: return f240;
+		case 241: return f241;
+		case 242: return f242;
+		case 243: return f243;
+		case 244: return f244;
+		case 245: return f245;
+		case 246: return f246;
+		case 247: return f247;
+		case 248: return f248;
+		case 249: return f249;
+		case 250: return f250;
+		case 251: return f251;
+		case 252: return f252;
+		case 253: return f253;
+		case 254: return f254;
+		case 255: return f255;
+		default: return (void*)(0);
+	}
+}
+*/
+import "C"
+
+// bindingChunk1 returns the trampoline for the binding at `index`
+func bindingChunk1(index int) C.WrenForeignMethodFn {
+	return C.get_f1(C.int(index))
+}
+
+//export f128
+func f128(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(128)
+	}
+}
+
+//export f129
+func f129(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(129)
+	}
+}
+
+//export f130
+func f130(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(130)
+	}
+}
+
+//export f131
+func f131(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(131)
+	}
+}
+
+//export f132
+func f132(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(132)
+	}
+}
+
+//export f133
+func f133(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(133)
+	}
+}
+
+//export f134
+func f134(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(134)
+	}
+}
+
+//export f135
+func f135(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(135)
+	}
+}
+
+//export f136
+func f136(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(136)
+	}
+}
+
+//export f137
+func f137(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(137)
+	}
+}
+
+//export f138
+func f138(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(138)
+	}
+}
+
+//export f139
+func f139(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(139)
+	}
+}
+
+//export f140
+func f140(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(140)
+	}
+}
+
+//export f141
+func f141(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(141)
+	}
+}
+
+//export f142
+func f142(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(142)
+	}
+}
+
+//export f143
+func f143(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(143)
+	}
+}
+
+//export f144
+func f144(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(144)
+	}
+}
+
+//export f145
+func f145(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(145)
+	}
+}
+
+//export f146
+func f146(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(146)
+	}
+}
+
+//export f147
+func f147(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(147)
+	}
+}
+
+//export f148
+func f148(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(148)
+	}
+}
+
+//export f149
+func f149(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(149)
+	}
+}
+
+//export f150
+func f150(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(150)
+	}
+}
+
+//export f151
+func f151(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(151)
+	}
+}
+
+//export f152
+func f152(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(152)
+	}
+}
+
+//export f153
+func f153(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(153)
+	}
+}
+
+//export f154
+func f154(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(154)
+	}
+}
+
+//export f155
+func f155(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(155)
+	}
+}
+
+//export f156
+func f156(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(156)
+	}
+}
+
+//export f157
+func f157(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(157)
+	}
+}
+
+//export f158
+func f158(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(158)
+	}
+}
+
+//export f159
+func f159(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(159)
+	}
+}
+
+//export f160
+func f160(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(160)
+	}
+}
+
+//export f161
+func f161(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(161)
+	}
+}
+
+//export f162
+func f162(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(162)
+	}
+}
+
+//export f163
+func f163(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(163)
+	}
+}
+
+//export f164
+func f164(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(164)
+	}
+}
+
+//export f165
+func f165(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(165)
+	}
+}
+
+//export f166
+func f166(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(166)
+	}
+}
+
+//export f167
+func f167(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(167)
+	}
+}
+
+//export f168
+func f168(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(168)
+	}
+}
+
+//export f169
+func f169(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(169)
+	}
+}
+
+//export f170
+func f170(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(170)
+	}
+}
+
+//export f171
+func f171(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(171)
+	}
+}
+
+//export f172
+func f172(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(172)
+	}
+}
+
+//export f173
+func f173(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(173)
+	}
+}
+
+//export f174
+func f174(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(174)
+	}
+}
+
+//export f175
+func f175(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(175)
+	}
+}
+
+//export f176
+func f176(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(176)
+	}
+}
+
+//export f177
+func f177(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(177)
+	}
+}
+
+//export f178
+func f178(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(178)
+	}
+}
+
+//export f179
+func f179(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(179)
+	}
+}
+
+//export f180
+func f180(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(180)
+	}
+}
+
+//export f181
+func f181(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(181)
+	}
+}
+
+//export f182
+func f182(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(182)
+	}
+}
+
+//export f183
+func f183(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(183)
+	}
+}
+
+//export f184
+func f184(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(184)
+	}
+}
+
+//export f185
+func f185(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(185)
+	}
+}
+
+//export f186
+func f186(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(186)
+	}
+}
+
+//export f187
+func f187(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(187)
+	}
+}
+
+//export f188
+func f188(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(188)
+	}
+}
+
+//export f189
+func f189(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(189)
+	}
+}
+
+//export f190
+func f190(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(190)
+	}
+}
+
+//export f191
+func f191(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(191)
+	}
+}
+
+//export f192
+func f192(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(192)
+	}
+}
+
+//export f193
+func f193(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(193)
+	}
+}
+
+//export f194
+func f194(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(194)
+	}
+}
+
+//export f195
+func f195(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(195)
+	}
+}
+
+//export f196
+func f196(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(196)
+	}
+}
+
+//export f197
+func f197(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(197)
+	}
+}
+
+//export f198
+func f198(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(198)
+	}
+}
+
+//export f199
+func f199(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(199)
+	}
+}
+
+//export f200
+func f200(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(200)
+	}
+}
+
+//export f201
+func f201(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(201)
+	}
+}
+
+//export f202
+func f202(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(202)
+	}
+}
+
+//export f203
+func f203(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(203)
+	}
+}
+
+//export f204
+func f204(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(204)
+	}
+}
+
+//export f205
+func f205(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(205)
+	}
+}
+
+//export f206
+func f206(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(206)
+	}
+}
+
+//export f207
+func f207(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(207)
+	}
+}
+
+//export f208
+func f208(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(208)
+	}
+}
+
+//export f209
+func f209(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(209)
+	}
+}
+
+//export f210
+func f210(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(210)
+	}
+}
+
+//export f211
+func f211(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(211)
+	}
+}
+
+//export f212
+func f212(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(212)
+	}
+}
+
+//export f213
+func f213(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(213)
+	}
+}
+
+//export f214
+func f214(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(214)
+	}
+}
+
+//export f215
+func f215(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(215)
+	}
+}
+
+//export f216
+func f216(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(216)
+	}
+}
+
+//export f217
+func f217(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(217)
+	}
+}
+
+//export f218
+func f218(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(218)
+	}
+}
+
+//export f219
+func f219(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(219)
+	}
+}
+
+//export f220
+func f220(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(220)
+	}
+}
+
+//export f221
+func f221(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(221)
+	}
+}
+
+//export f222
+func f222(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(222)
+	}
+}
+
+//export f223
+func f223(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(223)
+	}
+}
+
+//export f224
+func f224(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(224)
+	}
+}
+
+//export f225
+func f225(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(225)
+	}
+}
+
+//export f226
+func f226(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(226)
+	}
+}
+
+//export f227
+func f227(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(227)
+	}
+}
+
+//export f228
+func f228(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(228)
+	}
+}
+
+//export f229
+func f229(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(229)
+	}
+}
+
+//export f230
+func f230(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(230)
+	}
+}
+
+//export f231
+func f231(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(231)
+	}
+}
+
+//export f232
+func f232(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(232)
+	}
+}
+
+//export f233
+func f233(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(233)
+	}
+}
+
+//export f234
+func f234(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(234)
+	}
+}
+
+//export f235
+func f235(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(235)
+	}
+}
+
+//export f236
+func f236(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(236)
+	}
+}
+
+//export f237
+func f237(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(237)
+	}
+}
+
+//export f238
+func f238(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(238)
+	}
+}
+
+//export f239
+func f239(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(239)
+	}
+}
+
+//export f240
+func f240(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(240)
+	}
+}
+
+//export f241
+func f241(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(241)
+	}
+}
+
+//export f242
+func f242(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(242)
+	}
+}
+
+//export f243
+func f243(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(243)
+	}
+}
+
+//export f244
+func f244(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(244)
+	}
+}
+
+//export f245
+func f245(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(245)
+	}
+}
+
+//export f246
+func f246(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(246)
+	}
+}
+
+//export f247
+func f247(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(247)
+	}
+}
+
+//export f248
+func f248(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(248)
+	}
+}
+
+//export f249
+func f249(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(249)
+	}
+}
+
+//export f250
+func f250(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(250)
+	}
+}
+
+//export f251
+func f251(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(251)
+	}
+}
+
+//export f252
+func f252(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(252)
+	}
+}
+
+//export f253
+func f253(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(253)
+	}
+}
+
+//export f254
+func f254(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(254)
+	}
+}
+
+//export f255
+func f255(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(255)
+	}
+}
+
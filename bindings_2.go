@@ -0,0 +1,1175 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+
+extern void f256(WrenVM* vm);
+extern void f257(WrenVM* vm);
+extern void f258(WrenVM* vm);
+extern void f259(WrenVM* vm);
+extern void f260(WrenVM* vm);
+extern void f261(WrenVM* vm);
+extern void f262(WrenVM* vm);
+extern void f263(WrenVM* vm);
+extern void f264(WrenVM* vm);
+extern void f265(WrenVM* vm);
+extern void f266(WrenVM* vm);
+extern void f267(WrenVM* vm);
+extern void f268(WrenVM* vm);
+extern void f269(WrenVM* vm);
+extern void f270(WrenVM* vm);
+extern void f271(WrenVM* vm);
+extern void f272(WrenVM* vm);
+extern void f273(WrenVM* vm);
+extern void f274(WrenVM* vm);
+extern void f275(WrenVM* vm);
+extern void f276(WrenVM* vm);
+extern void f277(WrenVM* vm);
+extern void f278(WrenVM* vm);
+extern void f279(WrenVM* vm);
+extern void f280(WrenVM* vm);
+extern void f281(WrenVM* vm);
+extern void f282(WrenVM* vm);
+extern void f283(WrenVM* vm);
+extern void f284(WrenVM* vm);
+extern void f285(WrenVM* vm);
+extern void f286(WrenVM* vm);
+extern void f287(WrenVM* vm);
+extern void f288(WrenVM* vm);
+extern void f289(WrenVM* vm);
+extern void f290(WrenVM* vm);
+extern void f291(WrenVM* vm);
+extern void f292(WrenVM* vm);
+extern void f293(WrenVM* vm);
+extern void f294(WrenVM* vm);
+extern void f295(WrenVM* vm);
+extern void f296(WrenVM* vm);
+extern void f297(WrenVM* vm);
+extern void f298(WrenVM* vm);
+extern void f299(WrenVM* vm);
+extern void f300(WrenVM* vm);
+extern void f301(WrenVM* vm);
+extern void f302(WrenVM* vm);
+extern void f303(WrenVM* vm);
+extern void f304(WrenVM* vm);
+extern void f305(WrenVM* vm);
+extern void f306(WrenVM* vm);
+extern void f307(WrenVM* vm);
+extern void f308(WrenVM* vm);
+extern void f309(WrenVM* vm);
+extern void f310(WrenVM* vm);
+extern void f311(WrenVM* vm);
+extern void f312(WrenVM* vm);
+extern void f313(WrenVM* vm);
+extern void f314(WrenVM* vm);
+extern void f315(WrenVM* vm);
+extern void f316(WrenVM* vm);
+extern void f317(WrenVM* vm);
+extern void f318(WrenVM* vm);
+extern void f319(WrenVM* vm);
+extern void f320(WrenVM* vm);
+extern void f321(WrenVM* vm);
+extern void f322(WrenVM* vm);
+extern void f323(WrenVM* vm);
+extern void f324(WrenVM* vm);
+extern void f325(WrenVM* vm);
+extern void f326(WrenVM* vm);
+extern void f327(WrenVM* vm);
+extern void f328(WrenVM* vm);
+extern void f329(WrenVM* vm);
+extern void f330(WrenVM* vm);
+extern void f331(WrenVM* vm);
+extern void f332(WrenVM* vm);
+extern void f333(WrenVM* vm);
+extern void f334(WrenVM* vm);
+extern void f335(WrenVM* vm);
+extern void f336(WrenVM* vm);
+extern void f337(WrenVM* vm);
+extern void f338(WrenVM* vm);
+extern void f339(WrenVM* vm);
+extern void f340(WrenVM* vm);
+extern void f341(WrenVM* vm);
+extern void f342(WrenVM* vm);
+extern void f343(WrenVM* vm);
+extern void f344(WrenVM* vm);
+extern void f345(WrenVM* vm);
+extern void f346(WrenVM* vm);
+extern void f347(WrenVM* vm);
+extern void f348(WrenVM* vm);
+extern void f349(WrenVM* vm);
+extern void f350(WrenVM* vm);
+extern void f351(WrenVM* vm);
+extern void f352(WrenVM* vm);
+extern void f353(WrenVM* vm);
+extern void f354(WrenVM* vm);
+extern void f355(WrenVM* vm);
+extern void f356(WrenVM* vm);
+extern void f357(WrenVM* vm);
+extern void f358(WrenVM* vm);
+extern void f359(WrenVM* vm);
+extern void f360(WrenVM* vm);
+extern void f361(WrenVM* vm);
+extern void f362(WrenVM* vm);
+extern void f363(WrenVM* vm);
+extern void f364(WrenVM* vm);
+extern void f365(WrenVM* vm);
+extern void f366(WrenVM* vm);
+extern void f367(WrenVM* vm);
+extern void f368(WrenVM* vm);
+extern void f369(WrenVM* vm);
+extern void f370(WrenVM* vm);
+extern void f371(WrenVM* vm);
+extern void f372(WrenVM* vm);
+extern void f373(WrenVM* vm);
+extern void f374(WrenVM* vm);
+extern void f375(WrenVM* vm);
+extern void f376(WrenVM* vm);
+extern void f377(WrenVM* vm);
+extern void f378(WrenVM* vm);
+extern void f379(WrenVM* vm);
+extern void f380(WrenVM* vm);
+extern void f381(WrenVM* vm);
+extern void f382(WrenVM* vm);
+extern void f383(WrenVM* vm);
+
+static inline WrenForeignMethodFn get_f2(int i) {
+	switch (i) {
+		case 256: return f256;
+		case 257: return f257;
+		case 258: return f258;
+		case 259: return f259;
+		case 260: return f260;
+		case 261: return f261;
+		case 262: return f262;
+		case 263: return f263;
+		case 264: return f264;
+		case 265: return f265;
+		case 266: return f266;
+		case 267: return f267;
+		case 268: return f268;
+		case 269: return f269;
+		case 270: return f270;
+		case 271: return f271;
+		case 272: return f272;
+		case 273: return f273;
+		case 274: return f274;
+		case 275: return f275;
+		case 276: return f276;
+		case 277: return f277;
+		case 278: return f278;
+		case 279: return f279;
+		case 280: return f280;
+		case 281: return f281;
+		case 282: return f282;
+		case 283: return f283;
+		case 284: return f284;
+		case 285: return f285;
+		case 286: return f286;
+		case 287: return f287;
+		case 288: return f288;
+		case 289: return f289;
+		case 290: return f290;
+		case 291: return f291;
+		case 292: return f292;
+		case 293: return f293;
+		case 294: return f294;
+		case 295: return f295;
+		case 296: return f296;
+		case 297: return f297;
+		case 298: return f298;
+		case 299: return f299;
+		case 300: return f300;
+		case 301: return f301;
+		case 302: return f302;
+		case 303: return f303;
+		case 304: return f304;
+		case 305: return f305;
+		case 306: return f306;
+		case 307: return f307;
+		case 308: return f308;
+		case 309: return f309;
+		case 310: return f310;
+		case 311: return f311;
+		case 312: return f312;
+		case 313: return f313;
+		case 314: return f314;
+		case 315: return f315;
+		case 316: return f316;
+		case 317: return f317;
+		case 318: return f318;
+		case 319: return f319;
+		case 320: return f320;
+		case 321: return f321;
+		case 322: return f322;
+		case 323: return f323;
+		case 324: return f324;
+		case 325: return f325;
+		case 326: return f326;
+		case 327: return f327;
+		case 328: return f328;
+		case 329: return f329;
+		case 330: return f330;
+		case 331: return f331;
+		case 332: return f332;
+		case 333: return f333;
+		case 334: return f334;
+		case 335: return f335;
+		case 336: return f336;
+		case 337: return f337;
+		case 338: return f338;
+		case 339: return f339;
+		case 340: return f340;
+		case 341: return f341;
+		case 342: return f342;
+		case 343: return f343;
+		case 344: return f344;
+		case 345: return f345;
+		case 346: return f346;
+		case 347: return f347;
+		case 348: return f348;
+		case 349: return f349;
+		case 350: return f350;
+		case 351: return f351;
+		case 352: return f352;
+		case 353: return f353;
+		case 354: return f354;
+		case 355: return f355;
+		case 356: return f356;
+		case 357: return f357;
+		case 358: return f358;
+		case 359: return f359;
+		case 360: return f360;
+		case 361: return f361;
+		case 362: return f362;
+		case 363: return f363;
+		case 364: return f364;
+		case 365: return f365;
+		case 366: return f366;
+		case 367: return f367;
+		case 368: return f368;
+		case 369: return f369;
+		case 370: return f370;
+		case 371: return f371;
+		case 372: return f372;
+		case 373: return f373;
+		case 374: return f374;
+		case 375: return f375;
+		case 376: return f376;
+		case 377: return f377;
+		case 378: return f378;
+		case 379: return f379;
+		case 380: return f380;
+		case 381: return f381;
+		case 382: return f382;
+		case 383: return f383;
+		default: return (void*)(0);
+	}
+}
+*/
+import "C"
+
+// bindingChunk2 returns the trampoline for the binding at `index`
+func bindingChunk2(index int) C.WrenForeignMethodFn {
+	return C.get_f2(C.int(index))
+}
+
+//export f256
+func f256(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(256)
+	}
+}
+
+//export f257
+func f257(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(257)
+	}
+}
+
+//export f258
+func f258(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(258)
+	}
+}
+
+//export f259
+func f259(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(259)
+	}
+}
+
+//export f260
+func f260(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(260)
+	}
+}
+
+//export f261
+func f261(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(261)
+	}
+}
+
+//export f262
+func f262(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(262)
+	}
+}
+
+//export f263
+func f263(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(263)
+	}
+}
+
+//export f264
+func f264(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(264)
+	}
+}
+
+//export f265
+func f265(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(265)
+	}
+}
+
+//export f266
+func f266(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(266)
+	}
+}
+
+//export f267
+func f267(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(267)
+	}
+}
+
+//export f268
+func f268(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(268)
+	}
+}
+
+//export f269
+func f269(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(269)
+	}
+}
+
+//export f270
+func f270(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(270)
+	}
+}
+
+//export f271
+func f271(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(271)
+	}
+}
+
+//export f272
+func f272(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(272)
+	}
+}
+
+//export f273
+func f273(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(273)
+	}
+}
+
+//export f274
+func f274(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(274)
+	}
+}
+
+//export f275
+func f275(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(275)
+	}
+}
+
+//export f276
+func f276(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(276)
+	}
+}
+
+//export f277
+func f277(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(277)
+	}
+}
+
+//export f278
+func f278(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(278)
+	}
+}
+
+//export f279
+func f279(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(279)
+	}
+}
+
+//export f280
+func f280(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(280)
+	}
+}
+
+//export f281
+func f281(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(281)
+	}
+}
+
+//export f282
+func f282(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(282)
+	}
+}
+
+//export f283
+func f283(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(283)
+	}
+}
+
+//export f284
+func f284(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(284)
+	}
+}
+
+//export f285
+func f285(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(285)
+	}
+}
+
+//export f286
+func f286(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(286)
+	}
+}
+
+//export f287
+func f287(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(287)
+	}
+}
+
+//export f288
+func f288(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(288)
+	}
+}
+
+//export f289
+func f289(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(289)
+	}
+}
+
+//export f290
+func f290(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(290)
+	}
+}
+
+//export f291
+func f291(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(291)
+	}
+}
+
+//export f292
+func f292(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(292)
+	}
+}
+
+//export f293
+func f293(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(293)
+	}
+}
+
+//export f294
+func f294(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(294)
+	}
+}
+
+//export f295
+func f295(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(295)
+	}
+}
+
+//export f296
+func f296(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(296)
+	}
+}
+
+//export f297
+func f297(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(297)
+	}
+}
+
+//export f298
+func f298(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(298)
+	}
+}
+
+//export f299
+func f299(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(299)
+	}
+}
+
+//export f300
+func f300(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(300)
+	}
+}
+
+//export f301
+func f301(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(301)
+	}
+}
+
+//export f302
+func f302(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(302)
+	}
+}
+
+//export f303
+func f303(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(303)
+	}
+}
+
+//export f304
+func f304(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(304)
+	}
+}
+
+//export f305
+func f305(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(305)
+	}
+}
+
+//export f306
+func f306(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(306)
+	}
+}
+
+//export f307
+func f307(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(307)
+	}
+}
+
+//export f308
+func f308(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(308)
+	}
+}
+
+//export f309
+func f309(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(309)
+	}
+}
+
+//export f310
+func f310(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(310)
+	}
+}
+
+//export f311
+func f311(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(311)
+	}
+}
+
+//export f312
+func f312(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(312)
+	}
+}
+
+//export f313
+func f313(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(313)
+	}
+}
+
+//export f314
+func f314(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(314)
+	}
+}
+
+//export f315
+func f315(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(315)
+	}
+}
+
+//export f316
+func f316(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(316)
+	}
+}
+
+//export f317
+func f317(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(317)
+	}
+}
+
+//export f318
+func f318(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(318)
+	}
+}
+
+//export f319
+func f319(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(319)
+	}
+}
+
+//export f320
+func f320(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(320)
+	}
+}
+
+//export f321
+func f321(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(321)
+	}
+}
+
+//export f322
+func f322(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(322)
+	}
+}
+
+//export f323
+func f323(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(323)
+	}
+}
+
+//export f324
+func f324(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(324)
+	}
+}
+
+//export f325
+func f325(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(325)
+	}
+}
+
+//export f326
+func f326(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(326)
+	}
+}
+
+//export f327
+func f327(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(327)
+	}
+}
+
+//export f328
+func f328(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(328)
+	}
+}
+
+//export f329
+func f329(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(329)
+	}
+}
+
+//export f330
+func f330(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(330)
+	}
+}
+
+//export f331
+func f331(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(331)
+	}
+}
+
+//export f332
+func f332(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(332)
+	}
+}
+
+//export f333
+func f333(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(333)
+	}
+}
+
+//export f334
+func f334(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(334)
+	}
+}
+
+//export f335
+func f335(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(335)
+	}
+}
+
+//export f336
+func f336(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(336)
+	}
+}
+
+//export f337
+func f337(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(337)
+	}
+}
+
+//export f338
+func f338(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(338)
+	}
+}
+
+//export f339
+func f339(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(339)
+	}
+}
+
+//export f340
+func f340(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(340)
+	}
+}
+
+//export f341
+func f341(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(341)
+	}
+}
+
+//export f342
+func f342(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(342)
+	}
+}
+
+//export f343
+func f343(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(343)
+	}
+}
+
+//export f344
+func f344(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(344)
+	}
+}
+
+//export f345
+func f345(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(345)
+	}
+}
+
+//export f346
+func f346(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(346)
+	}
+}
+
+//export f347
+func f347(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(347)
+	}
+}
+
+//export f348
+func f348(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(348)
+	}
+}
+
+//export f349
+func f349(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(349)
+	}
+}
+
+//export f350
+func f350(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(350)
+	}
+}
+
+//export f351
+func f351(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(351)
+	}
+}
+
+//export f352
+func f352(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(352)
+	}
+}
+
+//export f353
+func f353(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(353)
+	}
+}
+
+//export f354
+func f354(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(354)
+	}
+}
+
+//export f355
+func f355(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(355)
+	}
+}
+
+//export f356
+func f356(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(356)
+	}
+}
+
+//export f357
+func f357(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(357)
+	}
+}
+
+//export f358
+func f358(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(358)
+	}
+}
+
+//export f359
+func f359(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(359)
+	}
+}
+
+//export f360
+func f360(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(360)
+	}
+}
+
+//export f361
+func f361(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(361)
+	}
+}
+
+//export f362
+func f362(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(362)
+	}
+}
+
+//export f363
+func f363(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(363)
+	}
+}
+
+//export f364
+func f364(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(364)
+	}
+}
+
+//export f365
+func f365(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(365)
+	}
+}
+
+//export f366
+func f366(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(366)
+	}
+}
+
+//export f367
+func f367(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(367)
+	}
+}
+
+//export f368
+func f368(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(368)
+	}
+}
+
+//export f369
+func f369(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(369)
+	}
+}
+
+//export f370
+func f370(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(370)
+	}
+}
+
+//export f371
+func f371(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(371)
+	}
+}
+
+//export f372
+func f372(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(372)
+	}
+}
+
+//export f373
+func f373(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(373)
+	}
+}
+
+//export f374
+func f374(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(374)
+	}
+}
+
+//export f375
+func f375(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(375)
+	}
+}
+
+//export f376
+func f376(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(376)
+	}
+}
+
+//export f377
+func f377(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(377)
+	}
+}
+
+//export f378
+func f378(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(378)
+	}
+}
+
+//export f379
+func f379(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(379)
+	}
+}
+
+//export f380
+func f380(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(380)
+	}
+}
+
+//export f381
+func f381(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(381)
+	}
+}
+
+//export f382
+func f382(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(382)
+	}
+}
+
+//export f383
+func f383(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(383)
+	}
+}
+
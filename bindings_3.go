@@ -0,0 +1,1175 @@
+// Code generated by go generate; DO NOT EDIT.
+
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+
+extern void f384(WrenVM* vm);
+extern void f385(WrenVM* vm);
+extern void f386(WrenVM* vm);
+extern void f387(WrenVM* vm);
+extern void f388(WrenVM* vm);
+extern void f389(WrenVM* vm);
+extern void f390(WrenVM* vm);
+extern void f391(WrenVM* vm);
+extern void f392(WrenVM* vm);
+extern void f393(WrenVM* vm);
+extern void f394(WrenVM* vm);
+extern void f395(WrenVM* vm);
+extern void f396(WrenVM* vm);
+extern void f397(WrenVM* vm);
+extern void f398(WrenVM* vm);
+extern void f399(WrenVM* vm);
+extern void f400(WrenVM* vm);
+extern void f401(WrenVM* vm);
+extern void f402(WrenVM* vm);
+extern void f403(WrenVM* vm);
+extern void f404(WrenVM* vm);
+extern void f405(WrenVM* vm);
+extern void f406(WrenVM* vm);
+extern void f407(WrenVM* vm);
+extern void f408(WrenVM* vm);
+extern void f409(WrenVM* vm);
+extern void f410(WrenVM* vm);
+extern void f411(WrenVM* vm);
+extern void f412(WrenVM* vm);
+extern void f413(WrenVM* vm);
+extern void f414(WrenVM* vm);
+extern void f415(WrenVM* vm);
+extern void f416(WrenVM* vm);
+extern void f417(WrenVM* vm);
+extern void f418(WrenVM* vm);
+extern void f419(WrenVM* vm);
+extern void f420(WrenVM* vm);
+extern void f421(WrenVM* vm);
+extern void f422(WrenVM* vm);
+extern void f423(WrenVM* vm);
+extern void f424(WrenVM* vm);
+extern void f425(WrenVM* vm);
+extern void f426(WrenVM* vm);
+extern void f427(WrenVM* vm);
+extern void f428(WrenVM* vm);
+extern void f429(WrenVM* vm);
+extern void f430(WrenVM* vm);
+extern void f431(WrenVM* vm);
+extern void f432(WrenVM* vm);
+extern void f433(WrenVM* vm);
+extern void f434(WrenVM* vm);
+extern void f435(WrenVM* vm);
+extern void f436(WrenVM* vm);
+extern void f437(WrenVM* vm);
+extern void f438(WrenVM* vm);
+extern void f439(WrenVM* vm);
+extern void f440(WrenVM* vm);
+extern void f441(WrenVM* vm);
+extern void f442(WrenVM* vm);
+extern void f443(WrenVM* vm);
+extern void f444(WrenVM* vm);
+extern void f445(WrenVM* vm);
+extern void f446(WrenVM* vm);
+extern void f447(WrenVM* vm);
+extern void f448(WrenVM* vm);
+extern void f449(WrenVM* vm);
+extern void f450(WrenVM* vm);
+extern void f451(WrenVM* vm);
+extern void f452(WrenVM* vm);
+extern void f453(WrenVM* vm);
+extern void f454(WrenVM* vm);
+extern void f455(WrenVM* vm);
+extern void f456(WrenVM* vm);
+extern void f457(WrenVM* vm);
+extern void f458(WrenVM* vm);
+extern void f459(WrenVM* vm);
+extern void f460(WrenVM* vm);
+extern void f461(WrenVM* vm);
+extern void f462(WrenVM* vm);
+extern void f463(WrenVM* vm);
+extern void f464(WrenVM* vm);
+extern void f465(WrenVM* vm);
+extern void f466(WrenVM* vm);
+extern void f467(WrenVM* vm);
+extern void f468(WrenVM* vm);
+extern void f469(WrenVM* vm);
+extern void f470(WrenVM* vm);
+extern void f471(WrenVM* vm);
+extern void f472(WrenVM* vm);
+extern void f473(WrenVM* vm);
+extern void f474(WrenVM* vm);
+extern void f475(WrenVM* vm);
+extern void f476(WrenVM* vm);
+extern void f477(WrenVM* vm);
+extern void f478(WrenVM* vm);
+extern void f479(WrenVM* vm);
+extern void f480(WrenVM* vm);
+extern void f481(WrenVM* vm);
+extern void f482(WrenVM* vm);
+extern void f483(WrenVM* vm);
+extern void f484(WrenVM* vm);
+extern void f485(WrenVM* vm);
+extern void f486(WrenVM* vm);
+extern void f487(WrenVM* vm);
+extern void f488(WrenVM* vm);
+extern void f489(WrenVM* vm);
+extern void f490(WrenVM* vm);
+extern void f491(WrenVM* vm);
+extern void f492(WrenVM* vm);
+extern void f493(WrenVM* vm);
+extern void f494(WrenVM* vm);
+extern void f495(WrenVM* vm);
+extern void f496(WrenVM* vm);
+extern void f497(WrenVM* vm);
+extern void f498(WrenVM* vm);
+extern void f499(WrenVM* vm);
+extern void f500(WrenVM* vm);
+extern void f501(WrenVM* vm);
+extern void f502(WrenVM* vm);
+extern void f503(WrenVM* vm);
+extern void f504(WrenVM* vm);
+extern void f505(WrenVM* vm);
+extern void f506(WrenVM* vm);
+extern void f507(WrenVM* vm);
+extern void f508(WrenVM* vm);
+extern void f509(WrenVM* vm);
+extern void f510(WrenVM* vm);
+extern void f511(WrenVM* vm);
+
+static inline WrenForeignMethodFn get_f3(int i) {
+	switch (i) {
+		case 384: return f384;
+		case 385: return f385;
+		case 386: return f386;
+		case 387: return f387;
+		case 388: return f388;
+		case 389: return f389;
+		case 390: return f390;
+		case 391: return f391;
+		case 392: return f392;
+		case 393: return f393;
+		case 394: return f394;
+		case 395: return f395;
+		case 396: return f396;
+		case 397: return f397;
+		case 398: return f398;
+		case 399: return f399;
+		case 400: return f400;
+		case 401: return f401;
+		case 402: return f402;
+		case 403: return f403;
+		case 404: return f404;
+		case 405: return f405;
+		case 406: return f406;
+		case 407: return f407;
+		case 408: return f408;
+		case 409: return f409;
+		case 410: return f410;
+		case 411: return f411;
+		case 412: return f412;
+		case 413: return f413;
+		case 414: return f414;
+		case 415: return f415;
+		case 416: return f416;
+		case 417: return f417;
+		case 418: return f418;
+		case 419: return f419;
+		case 420: return f420;
+		case 421: return f421;
+		case 422: return f422;
+		case 423: return f423;
+		case 424: return f424;
+		case 425: return f425;
+		case 426: return f426;
+		case 427: return f427;
+		case 428: return f428;
+		case 429: return f429;
+		case 430: return f430;
+		case 431: return f431;
+		case 432: return f432;
+		case 433: return f433;
+		case 434: return f434;
+		case 435: return f435;
+		case 436: return f436;
+		case 437: return f437;
+		case 438: return f438;
+		case 439: return f439;
+		case 440: return f440;
+		case 441: return f441;
+		case 442: return f442;
+		case 443: return f443;
+		case 444: return f444;
+		case 445: return f445;
+		case 446: return f446;
+		case 447: return f447;
+		case 448: return f448;
+		case 449: return f449;
+		case 450: return f450;
+		case 451: return f451;
+		case 452: return f452;
+		case 453: return f453;
+		case 454: return f454;
+		case 455: return f455;
+		case 456: return f456;
+		case 457: return f457;
+		case 458: return f458;
+		case 459: return f459;
+		case 460: return f460;
+		case 461: return f461;
+		case 462: return f462;
+		case 463: return f463;
+		case 464: return f464;
+		case 465: return f465;
+		case 466: return f466;
+		case 467: return f467;
+		case 468: return f468;
+		case 469: return f469;
+		case 470: return f470;
+		case 471: return f471;
+		case 472: return f472;
+		case 473: return f473;
+		case 474: return f474;
+		case 475: return f475;
+		case 476: return f476;
+		case 477: return f477;
+		case 478: return f478;
+		case 479: return f479;
+		case 480: return f480;
+		case 481: return f481;
+		case 482: return f482;
+		case 483: return f483;
+		case 484: return f484;
+		case 485: return f485;
+		case 486: return f486;
+		case 487: return f487;
+		case 488: return f488;
+		case 489: return f489;
+		case 490: return f490;
+		case 491: return f491;
+		case 492: return f492;
+		case 493: return f493;
+		case 494: return f494;
+		case 495: return f495;
+		case 496: return f496;
+		case 497: return f497;
+		case 498: return f498;
+		case 499: return f499;
+		case 500: return f500;
+		case 501: return f501;
+		case 502: return f502;
+		case 503: return f503;
+		case 504: return f504;
+		case 505: return f505;
+		case 506: return f506;
+		case 507: return f507;
+		case 508: return f508;
+		case 509: return f509;
+		case 510: return f510;
+		case 511: return f511;
+		default: return (void*)(0);
+	}
+}
+*/
+import "C"
+
+// bindingChunk3 returns the trampoline for the binding at `index`
+func bindingChunk3(index int) C.WrenForeignMethodFn {
+	return C.get_f3(C.int(index))
+}
+
+//export f384
+func f384(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(384)
+	}
+}
+
+//export f385
+func f385(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(385)
+	}
+}
+
+//export f386
+func f386(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(386)
+	}
+}
+
+//export f387
+func f387(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(387)
+	}
+}
+
+//export f388
+func f388(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(388)
+	}
+}
+
+//export f389
+func f389(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(389)
+	}
+}
+
+//export f390
+func f390(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(390)
+	}
+}
+
+//export f391
+func f391(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(391)
+	}
+}
+
+//export f392
+func f392(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(392)
+	}
+}
+
+//export f393
+func f393(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(393)
+	}
+}
+
+//export f394
+func f394(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(394)
+	}
+}
+
+//export f395
+func f395(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(395)
+	}
+}
+
+//export f396
+func f396(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(396)
+	}
+}
+
+//export f397
+func f397(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(397)
+	}
+}
+
+//export f398
+func f398(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(398)
+	}
+}
+
+//export f399
+func f399(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(399)
+	}
+}
+
+//export f400
+func f400(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(400)
+	}
+}
+
+//export f401
+func f401(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(401)
+	}
+}
+
+//export f402
+func f402(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(402)
+	}
+}
+
+//export f403
+func f403(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(403)
+	}
+}
+
+//export f404
+func f404(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(404)
+	}
+}
+
+//export f405
+func f405(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(405)
+	}
+}
+
+//export f406
+func f406(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(406)
+	}
+}
+
+//export f407
+func f407(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(407)
+	}
+}
+
+//export f408
+func f408(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(408)
+	}
+}
+
+//export f409
+func f409(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(409)
+	}
+}
+
+//export f410
+func f410(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(410)
+	}
+}
+
+//export f411
+func f411(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(411)
+	}
+}
+
+//export f412
+func f412(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(412)
+	}
+}
+
+//export f413
+func f413(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(413)
+	}
+}
+
+//export f414
+func f414(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(414)
+	}
+}
+
+//export f415
+func f415(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(415)
+	}
+}
+
+//export f416
+func f416(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(416)
+	}
+}
+
+//export f417
+func f417(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(417)
+	}
+}
+
+//export f418
+func f418(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(418)
+	}
+}
+
+//export f419
+func f419(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(419)
+	}
+}
+
+//export f420
+func f420(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(420)
+	}
+}
+
+//export f421
+func f421(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(421)
+	}
+}
+
+//export f422
+func f422(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(422)
+	}
+}
+
+//export f423
+func f423(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(423)
+	}
+}
+
+//export f424
+func f424(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(424)
+	}
+}
+
+//export f425
+func f425(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(425)
+	}
+}
+
+//export f426
+func f426(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(426)
+	}
+}
+
+//export f427
+func f427(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(427)
+	}
+}
+
+//export f428
+func f428(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(428)
+	}
+}
+
+//export f429
+func f429(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(429)
+	}
+}
+
+//export f430
+func f430(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(430)
+	}
+}
+
+//export f431
+func f431(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(431)
+	}
+}
+
+//export f432
+func f432(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(432)
+	}
+}
+
+//export f433
+func f433(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(433)
+	}
+}
+
+//export f434
+func f434(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(434)
+	}
+}
+
+//export f435
+func f435(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(435)
+	}
+}
+
+//export f436
+func f436(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(436)
+	}
+}
+
+//export f437
+func f437(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(437)
+	}
+}
+
+//export f438
+func f438(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(438)
+	}
+}
+
+//export f439
+func f439(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(439)
+	}
+}
+
+//export f440
+func f440(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(440)
+	}
+}
+
+//export f441
+func f441(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(441)
+	}
+}
+
+//export f442
+func f442(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(442)
+	}
+}
+
+//export f443
+func f443(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(443)
+	}
+}
+
+//export f444
+func f444(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(444)
+	}
+}
+
+//export f445
+func f445(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(445)
+	}
+}
+
+//export f446
+func f446(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(446)
+	}
+}
+
+//export f447
+func f447(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(447)
+	}
+}
+
+//export f448
+func f448(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(448)
+	}
+}
+
+//export f449
+func f449(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(449)
+	}
+}
+
+//export f450
+func f450(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(450)
+	}
+}
+
+//export f451
+func f451(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(451)
+	}
+}
+
+//export f452
+func f452(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(452)
+	}
+}
+
+//export f453
+func f453(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(453)
+	}
+}
+
+//export f454
+func f454(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(454)
+	}
+}
+
+//export f455
+func f455(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(455)
+	}
+}
+
+//export f456
+func f456(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(456)
+	}
+}
+
+//export f457
+func f457(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(457)
+	}
+}
+
+//export f458
+func f458(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(458)
+	}
+}
+
+//export f459
+func f459(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(459)
+	}
+}
+
+//export f460
+func f460(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(460)
+	}
+}
+
+//export f461
+func f461(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(461)
+	}
+}
+
+//export f462
+func f462(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(462)
+	}
+}
+
+//export f463
+func f463(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(463)
+	}
+}
+
+//export f464
+func f464(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(464)
+	}
+}
+
+//export f465
+func f465(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(465)
+	}
+}
+
+//export f466
+func f466(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(466)
+	}
+}
+
+//export f467
+func f467(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(467)
+	}
+}
+
+//export f468
+func f468(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(468)
+	}
+}
+
+//export f469
+func f469(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(469)
+	}
+}
+
+//export f470
+func f470(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(470)
+	}
+}
+
+//export f471
+func f471(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(471)
+	}
+}
+
+//export f472
+func f472(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(472)
+	}
+}
+
+//export f473
+func f473(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(473)
+	}
+}
+
+//export f474
+func f474(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(474)
+	}
+}
+
+//export f475
+func f475(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(475)
+	}
+}
+
+//export f476
+func f476(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(476)
+	}
+}
+
+//export f477
+func f477(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(477)
+	}
+}
+
+//export f478
+func f478(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(478)
+	}
+}
+
+//export f479
+func f479(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(479)
+	}
+}
+
+//export f480
+func f480(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(480)
+	}
+}
+
+//export f481
+func f481(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(481)
+	}
+}
+
+//export f482
+func f482(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(482)
+	}
+}
+
+//export f483
+func f483(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(483)
+	}
+}
+
+//export f484
+func f484(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(484)
+	}
+}
+
+//export f485
+func f485(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(485)
+	}
+}
+
+//export f486
+func f486(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(486)
+	}
+}
+
+//export f487
+func f487(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(487)
+	}
+}
+
+//export f488
+func f488(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(488)
+	}
+}
+
+//export f489
+func f489(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(489)
+	}
+}
+
+//export f490
+func f490(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(490)
+	}
+}
+
+//export f491
+func f491(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(491)
+	}
+}
+
+//export f492
+func f492(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(492)
+	}
+}
+
+//export f493
+func f493(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(493)
+	}
+}
+
+//export f494
+func f494(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(494)
+	}
+}
+
+//export f495
+func f495(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(495)
+	}
+}
+
+//export f496
+func f496(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(496)
+	}
+}
+
+//export f497
+func f497(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(497)
+	}
+}
+
+//export f498
+func f498(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(498)
+	}
+}
+
+//export f499
+func f499(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(499)
+	}
+}
+
+//export f500
+func f500(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(500)
+	}
+}
+
+//export f501
+func f501(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(501)
+	}
+}
+
+//export f502
+func f502(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(502)
+	}
+}
+
+//export f503
+func f503(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(503)
+	}
+}
+
+//export f504
+func f504(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(504)
+	}
+}
+
+//export f505
+func f505(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(505)
+	}
+}
+
+//export f506
+func f506(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(506)
+	}
+}
+
+//export f507
+func f507(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(507)
+	}
+}
+
+//export f508
+func f508(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(508)
+	}
+}
+
+//export f509
+func f509(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(509)
+	}
+}
+
+//export f510
+func f510(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(510)
+	}
+}
+
+//export f511
+func f511(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign(511)
+	}
+}
+
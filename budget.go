@@ -0,0 +1,83 @@
+package wren
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BudgetExceeded is returned if a script used up all of the fuel given to it by `Config.Fuel` or `Config.FuelFn`.
+//
+// A script uses one unit of fuel whenever it jumps back to the top of a loop or calls a method (foreign or not), which are the checkpoints where WrenGo can stop a script (see `InterpretStringContext`). Every loop and recursion uses fuel, so a script that runs out can't keep going by never calling into Go. Work done inside of a single method, such as by `List.filled` or a foreign method, only uses one unit
+type BudgetExceeded struct {
+	Used, Limit int64
+}
+
+func (err *BudgetExceeded) Error() string {
+	if err.Limit == 0 {
+		return fmt.Sprintf("Script exceeded its budget after using %v fuel", err.Used)
+	}
+	return fmt.Sprintf("Script used %v fuel but its limit is %v", err.Used, err.Limit)
+}
+
+// TimeoutError is returned if an interpretation or call ran for longer than `Config.MaxExecutionTime`. Like other interrupts, the script is stopped at its next loop iteration or method call, so only a foreign method that doesn't return can keep it running
+type TimeoutError struct {
+	Limit time.Duration
+}
+
+func (err *TimeoutError) Error() string {
+	return fmt.Sprintf("Script took longer than %v to run", err.Limit)
+}
+
+// Unwrap returns `context.DeadlineExceeded`
+func (err *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// startWatchdog interrupts the VM with `TimeoutError` once the run that is starting takes longer than `Config.MaxExecutionTime`. The returned function stops the watchdog and waits for it to exit, so it can't interrupt the next run
+func (vm *VM) startWatchdog() func() {
+	limit := vm.Config.MaxExecutionTime
+	if limit <= 0 {
+		return func() {}
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		timer := time.NewTimer(limit)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			vm.interrupt(&TimeoutError{Limit: limit})
+		case <-done:
+		}
+	}()
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+// startFuel gives the run that is starting the fuel from `Config.Fuel` and makes every checkpoint call into Go if `Config.FuelFn` is set
+func (vm *VM) startFuel() {
+	if vm.Config == nil {
+		vm.heap.startSteps(0, false)
+		return
+	}
+	vm.heap.startSteps(vm.Config.Fuel, vm.Config.FuelFn != nil)
+}
+
+// consumeFuel returns `BudgetExceeded` if the fuel the interpreter counted at its checkpoints is used up
+func (vm *VM) consumeFuel() error {
+	if vm.Config == nil || vm.Config.Fuel == 0 && vm.Config.FuelFn == nil {
+		return nil
+	}
+	used := vm.heap.stepCount()
+	if limit := vm.Config.Fuel; limit > 0 && used > limit {
+		return &BudgetExceeded{Used: used, Limit: limit}
+	}
+	if fn := vm.Config.FuelFn; fn != nil && !fn(vm, used) {
+		return &BudgetExceeded{Used: used, Limit: vm.Config.Fuel}
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package wren
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ModuleBuilder builds a `Module` one class and method at a time, such as `NewModuleBuilder().Class("Foo").Constructor(fn).Method("bar(_)", fn).Build()`. Mistakes are collected and returned from `Build`
+type ModuleBuilder struct {
+	module *Module
+	errs   []error
+}
+
+// ClassBuilder adds to one class of a `ModuleBuilder`. `Class` and `Build` go back to the module
+type ClassBuilder struct {
+	module *ModuleBuilder
+	name   string
+	class  *ForeignClass
+}
+
+// DuplicateDefinition is returned from `ModuleBuilder.Build` if a class or method was added more than once. `Signature` is empty for classes
+type DuplicateDefinition struct {
+	Class, Signature string
+}
+
+func (err *DuplicateDefinition) Error() string {
+	if err.Signature == "" {
+		return fmt.Sprintf("Class \"%v\" is defined more than once", err.Class)
+	}
+	return fmt.Sprintf("Method \"%v\" in class \"%v\" is defined more than once", err.Signature, err.Class)
+}
+
+// ModuleBuildError is returned from `ModuleBuilder.Build` with every mistake found while building the module
+type ModuleBuildError struct {
+	Errors []error
+}
+
+func (err *ModuleBuildError) Error() string {
+	messages := make([]string, len(err.Errors))
+	for i, e := range err.Errors {
+		messages[i] = e.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// NewModuleBuilder starts building an empty module
+func NewModuleBuilder() *ModuleBuilder {
+	return &ModuleBuilder{module: NewModule(nil)}
+}
+
+// Source sets the Wren source of the module (see `Module.Source`)
+func (b *ModuleBuilder) Source(source string) *ModuleBuilder {
+	b.module.Source = source
+	return b
+}
+
+// Declare makes the module declare its classes when it is imported (see `Module.Declare`)
+func (b *ModuleBuilder) Declare() *ModuleBuilder {
+	b.module.Declare = true
+	return b
+}
+
+// Class starts a new class called `name`
+func (b *ModuleBuilder) Class(name string) *ClassBuilder {
+	if _, ok := b.module.ClassMap[name]; ok {
+		b.errs = append(b.errs, &DuplicateDefinition{Class: name})
+	}
+	class := NewClass(nil, nil, nil)
+	b.module.ClassMap[name] = class
+	return &ClassBuilder{module: b, name: name, class: class}
+}
+
+// Build returns the module, or `ModuleBuildError` if a class or method was added more than once or a signature is malformed
+func (b *ModuleBuilder) Build() (*Module, error) {
+	if len(b.errs) > 0 {
+		return nil, &ModuleBuildError{Errors: b.errs}
+	}
+	return b.module.Clone(), nil
+}
+
+// Constructor sets the function that creates instances of the class, making it a foreign class. `signatures` are the constructors `Module.WrenSource` declares for it (see `ForeignClass.Constructors`)
+func (b *ClassBuilder) Constructor(fn ForeignInitializer, signatures ...string) *ClassBuilder {
+	b.class.Initializer = fn
+	b.class.Constructors = append(b.class.Constructors, signatures...)
+	return b
+}
+
+// Finalizer sets the function that is called when instances of the class are garbage collected
+func (b *ClassBuilder) Finalizer(fn ForeignFinalizer) *ClassBuilder {
+	b.class.Finalizer = fn
+	return b
+}
+
+// Method adds a foreign method. `signature` may start with "static "
+func (b *ClassBuilder) Method(signature string, fn ForeignMethodFn) *ClassBuilder {
+	if _, err := ParseSignature(signature); err != nil {
+		err.(*SignatureError).Class = b.name
+		b.module.errs = append(b.module.errs, err)
+	}
+	if _, ok := b.class.MethodMap[signature]; ok {
+		b.module.errs = append(b.module.errs, &DuplicateDefinition{Class: b.name, Signature: signature})
+	}
+	b.class.MethodMap[signature] = fn
+	return b
+}
+
+// Static adds a static foreign method
+func (b *ClassBuilder) Static(signature string, fn ForeignMethodFn) *ClassBuilder {
+	return b.Method(Static(signature), fn)
+}
+
+// Class finishes this class and starts a new one called `name`
+func (b *ClassBuilder) Class(name string) *ClassBuilder {
+	return b.module.Class(name)
+}
+
+// Build finishes this class and builds the module (see `ModuleBuilder.Build`)
+func (b *ClassBuilder) Build() (*Module, error) {
+	return b.module.Build()
+}
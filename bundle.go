@@ -0,0 +1,163 @@
+package wren
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BundleConflict is returned from `Bundle` if two of the modules it combines declare the same module variable, which they can't once they share a module
+type BundleConflict struct {
+	Name    string
+	Modules [2]string
+}
+
+func (err *BundleConflict) Error() string {
+	return fmt.Sprintf("Modules \"%v\" and \"%v\" both declare \"%v\" so they can't be bundled", err.Modules[0], err.Modules[1], err.Name)
+}
+
+// bundler collects the modules of a bundle
+type bundler struct {
+	loader LoadModuleFn
+	// whether each module that was seen is part of the bundle
+	bundled map[string]bool
+	// the module that declares each variable of the bundle
+	declared map[string]string
+	// import statements for modules that aren't part of the bundle, and the key each is deduplicated by
+	imports []string
+	kept    map[string]bool
+	modules strings.Builder
+}
+
+// Bundle combines the module `entry` and the modules it imports (and the ones they import, and so on) into one source that can be passed to `InterpretString` without needing a module loader. Modules are loaded with `loader` (which is called with a nil VM) and relative names are resolved with `ResolveRelative`.
+//
+// Since every module becomes part of one module, imported modules come before the modules importing them (each only once) and their imports are removed. Imports that rename variables with `as` become `var` declarations. Modules `loader` can't load, such as Wren's optional modules and modules set from Go with `SetModule`, are still imported at the top of the bundle, so the VM running it has to provide them. Only imports at the top level of a module are bundled, as imports inside of methods and blocks happen at runtime. If two modules declare the same variable, `BundleConflict` is returned. If `entry` can't be loaded or an import can't be resolved, `ModuleNotFound` is returned
+func Bundle(entry string, loader LoadModuleFn) (string, error) {
+	source, ok := loader(nil, entry)
+	if !ok {
+		return "", &ModuleNotFound{Module: entry}
+	}
+	b := &bundler{loader: loader, bundled: map[string]bool{}, declared: map[string]string{}, kept: map[string]bool{}}
+	if err := b.add(entry, source); err != nil {
+		return "", err
+	}
+	var bundle strings.Builder
+	for _, statement := range b.imports {
+		bundle.WriteString(statement + "\n")
+	}
+	bundle.WriteString(b.modules.String())
+	return bundle.String(), nil
+}
+
+// add adds the module `name` to the bundle after the modules it imports
+func (b *bundler) add(name, source string) error {
+	b.bundled[name] = true
+	lines := strings.Split(source, "\n")
+	tokens := scanTokens(source)
+	depth := 0
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.kind == tokenPunct {
+			switch tok.text {
+			case "{", "(", "[":
+				depth++
+			case "}", ")", "]":
+				depth--
+			}
+			continue
+		}
+		if depth != 0 || tok.kind != tokenName || tok.text != "import" {
+			continue
+		}
+		module, names, next := parseImport(tokens, i)
+		i = next - 1
+		if module == "" {
+			continue
+		}
+		resolved, ok := ResolveRelative(nil, name, module)
+		if !ok {
+			return &ModuleNotFound{Module: module}
+		}
+		module = resolved
+		if err := b.load(module); err != nil {
+			return err
+		}
+		var replacement []string
+		if b.bundled[module] {
+			for _, decl := range names {
+				if decl.name != decl.from {
+					replacement = append(replacement, fmt.Sprintf("var %v = %v", decl.name, decl.from))
+				}
+			}
+		} else if err := b.keep(name, module, names); err != nil {
+			return err
+		}
+		// The statement may continue onto more lines after commas. Its first
+		// line gets the replacement and the rest are blanked out
+		first, last := tok.line-1, tokens[next-1].line-1
+		for line := first; line <= last; line++ {
+			lines[line] = ""
+		}
+		lines[first] = strings.Join(replacement, "\n")
+	}
+	source = strings.Join(lines, "\n")
+	for _, decl := range topLevelDeclarations(source) {
+		if err := b.declare(name, decl.name); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(&b.modules, "// module %q\n%v\n", name, strings.TrimRight(source, "\n"))
+	return nil
+}
+
+// load adds the module `name` to the bundle if it wasn't seen yet and `loader` can load it. Modules that import each other are only added once, so the one that was imported first comes after the other
+func (b *bundler) load(name string) error {
+	if _, seen := b.bundled[name]; seen {
+		return nil
+	}
+	if isBuiltinModule(name) {
+		b.bundled[name] = false
+		return nil
+	}
+	source, ok := b.loader(nil, name)
+	if !ok {
+		b.bundled[name] = false
+		return nil
+	}
+	return b.add(name, source)
+}
+
+// keep adds an import statement for `module`, which isn't part of the bundle, to the top of the bundle. Modules importing the same variables share one statement
+func (b *bundler) keep(importer, module string, names []declaration) error {
+	if len(names) == 0 {
+		if !b.kept[module] {
+			b.kept[module] = true
+			b.imports = append(b.imports, fmt.Sprintf("import %q", module))
+		}
+		return nil
+	}
+	for _, decl := range names {
+		key := module + "\x00" + decl.from + "\x00" + decl.name
+		if b.kept[key] {
+			continue
+		}
+		if err := b.declare(importer, decl.name); err != nil {
+			return err
+		}
+		b.kept[key] = true
+		statement := fmt.Sprintf("import %q for %v", module, decl.from)
+		if decl.name != decl.from {
+			statement += " as " + decl.name
+		}
+		b.imports = append(b.imports, statement)
+	}
+	return nil
+}
+
+// declare records that `module` declares the variable `name`
+func (b *bundler) declare(module, name string) error {
+	if other, ok := b.declared[name]; ok {
+		return &BundleConflict{Name: name, Modules: [2]string{other, module}}
+	}
+	b.declared[name] = module
+	return nil
+}
@@ -0,0 +1,78 @@
+package wren
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Capability names something a script may be allowed to do. A VM's config lists the capabilities it grants in `Config.Capabilities`
+type Capability string
+
+// Capabilities used by the optional modules that come with WrenGo
+const (
+	// Reading and writing files
+	CapabilityIO Capability = "io"
+	// Environment variables, process arguments and other access to the operating system
+	CapabilityOS Capability = "os"
+	// Making network requests
+	CapabilityHTTP Capability = "http"
+	// Querying databases
+	CapabilitySQL Capability = "sql"
+)
+
+type optionalModule struct {
+	capability Capability
+	module     *Module
+}
+
+var (
+	optionalModules    = make(map[string]optionalModule)
+	optionalModulesMux sync.RWMutex
+)
+
+// RegisterOptionalModule makes `module` importable by the name `name` from any VM whose config grants `capability`. Scripts in VMs that don't grant it get a `CapabilityDenied` error when importing the module. `module.Source` should contain the module's Wren source. Modules set on a VM with `SetModule` take priority over optional modules
+func RegisterOptionalModule(name string, capability Capability, module *Module) {
+	optionalModulesMux.Lock()
+	defer optionalModulesMux.Unlock()
+	optionalModules[name] = optionalModule{capability: capability, module: module.Clone()}
+}
+
+// UnregisterOptionalModule removes a module registered with `RegisterOptionalModule`
+func UnregisterOptionalModule(name string) {
+	optionalModulesMux.Lock()
+	defer optionalModulesMux.Unlock()
+	delete(optionalModules, name)
+}
+
+func lookupOptionalModule(name string) (optionalModule, bool) {
+	optionalModulesMux.RLock()
+	defer optionalModulesMux.RUnlock()
+	optional, ok := optionalModules[name]
+	return optional, ok
+}
+
+// CapabilityDenied is sent to `ErrorFn` if a script imports an optional module without its capability being granted
+type CapabilityDenied struct {
+	Module     string
+	Capability Capability
+}
+
+func (err *CapabilityDenied) Error() string {
+	return fmt.Sprintf("Importing module \"%v\" requires the \"%v\" capability which this VM does not grant", err.Module, err.Capability)
+}
+
+// HasCapability reports whether the VM's config grants `capability`. The empty capability is always granted
+func (vm *VM) HasCapability(capability Capability) bool {
+	if capability == "" {
+		return true
+	}
+	if vm.Config == nil {
+		return false
+	}
+	for _, granted := range vm.Config.Capabilities {
+		if granted == capability {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+*/
+import "C"
+import (
+	"fmt"
+	"reflect"
+)
+
+// Module that holds the foreign class Go channels are wrapped in
+const channelModule = "wrengo/channel"
+
+// ChannelClosed is what a fiber is aborted with if it sends to a Go channel that was closed
+type ChannelClosed struct{}
+
+func (err *ChannelClosed) Error() string {
+	return "Cannot send to a closed channel"
+}
+
+// ChannelDirection is what a fiber is aborted with if it receives from a send-only channel or sends to a receive-only one
+type ChannelDirection struct {
+	Dir reflect.ChanDir
+}
+
+func (err *ChannelDirection) Error() string {
+	return fmt.Sprintf("Channel of direction %v cannot be used this way", err.Dir)
+}
+
+// goChannel is the Go value of a channel passed to Wren
+type goChannel struct {
+	ch reflect.Value
+	// the value `wait_` received without waiting, for `take_` to return
+	pending interface{}
+}
+
+// receiver is a fiber waiting for a value from a Go channel
+type receiver struct {
+	channel *goChannel
+	fiber   *FiberHandle
+}
+
+// sender is a fiber waiting for a Go channel to take its value
+type sender struct {
+	channel *goChannel
+	value   reflect.Value
+	fiber   *FiberHandle
+}
+
+// channelModuleDefinition declares the class that wraps Go channels
+func channelModuleDefinition() *Module {
+	module := NewModule(ClassMap{
+		"GoChannel": NewClass(nil, nil, MethodMap{
+			"offer_(_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				channel, err := channelOf(parameters[0])
+				if err != nil {
+					return nil, err
+				}
+				element, err := channel.element(vm, parameters[1])
+				if err != nil {
+					return nil, err
+				}
+				if sent, err := trySend(channel.ch, element); sent || err != nil {
+					return false, err
+				}
+				fiber, err := parameters[2].(*FiberHandle).Copy()
+				if err != nil {
+					return nil, err
+				}
+				vm.senders = append(vm.senders, sender{channel: channel, value: element, fiber: fiber})
+				return true, nil
+			},
+			"wait_(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				channel, err := channelOf(parameters[0])
+				if err != nil {
+					return nil, err
+				}
+				if channel.ch.Type().ChanDir()&reflect.RecvDir == 0 {
+					return nil, &ChannelDirection{Dir: channel.ch.Type().ChanDir()}
+				}
+				if value, ok := channel.ch.TryRecv(); value.IsValid() {
+					channel.pending = received(value, ok)
+					return false, nil
+				}
+				fiber, err := parameters[1].(*FiberHandle).Copy()
+				if err != nil {
+					return nil, err
+				}
+				vm.receivers = append(vm.receivers, receiver{channel: channel, fiber: fiber})
+				return true, nil
+			},
+			"take_()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				channel, err := channelOf(parameters[0])
+				if err != nil {
+					return nil, err
+				}
+				value := channel.pending
+				channel.pending = nil
+				return vm.Return(value)
+			},
+		}),
+	})
+	module.Source = `
+foreign class GoChannel {
+	receive() {
+		if (wait_(Fiber.current)) return Fiber.suspend()
+		return take_()
+	}
+	send(value) {
+		if (offer_(value, Fiber.current)) Fiber.suspend()
+	}
+	foreign offer_(value, fiber)
+	foreign wait_(fiber)
+	foreign take_()
+}
+`
+	return module
+}
+
+// received returns the Go value of something received from a channel, or nil if the channel was closed
+func received(value reflect.Value, ok bool) interface{} {
+	if !ok {
+		return nil
+	}
+	return value.Interface()
+}
+
+func channelOf(value interface{}) (*goChannel, error) {
+	handle, ok := value.(*ForeignHandle)
+	if !ok {
+		return nil, &UnexpectedValue{Value: value}
+	}
+	return ForeignAs[*goChannel](handle)
+}
+
+// element converts `value` to the channel's element type for sending
+func (channel *goChannel) element(vm *VM, value interface{}) (reflect.Value, error) {
+	if channel.ch.Type().ChanDir()&reflect.SendDir == 0 {
+		return reflect.Value{}, &ChannelDirection{Dir: channel.ch.Type().ChanDir()}
+	}
+	element := reflect.New(channel.ch.Type().Elem()).Elem()
+	if err := vm.unmarshal(value, element, "argument 1"); err != nil {
+		return reflect.Value{}, err
+	}
+	return element, nil
+}
+
+// trySend sends `value` to `ch` if it can without waiting
+func trySend(ch, value reflect.Value) (sent bool, err error) {
+	defer func() {
+		// sending to a closed channel panics
+		if recover() != nil {
+			err = &ChannelClosed{}
+		}
+	}()
+	return ch.TrySend(value), nil
+}
+
+// NewChannel wraps the Go channel `ch` in an object scripts can use to talk to Go. `receive()` returns the next value from the channel, suspending the fiber that called it until a value arrives (or null once the channel is closed). `send(value)` converts `value` into the channel's element type with `Unmarshal` and sends it, suspending the fiber until the channel takes it if it is full. Waiting fibers are resumed by `VM.Poll` and `VM.Run`, so like `Timer.sleep` the interpretation or call that was running returns while the fiber waits. Like `NewFn`, this can be used while the VM is running once the module "wrengo/channel" was defined, otherwise `ModuleNotImported` is returned
+func (vm *VM) NewChannel(ch interface{}) (handle *ForeignHandle, err error) {
+	if vm.onThread(func() { handle, err = vm.NewChannel(ch) }) {
+		return handle, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
+	}
+	value := reflect.ValueOf(ch)
+	if value.Kind() != reflect.Chan || value.IsNil() {
+		return nil, &InvalidValue{Value: ch}
+	}
+	if err := vm.requireModule(channelModule, channelModuleDefinition); err != nil {
+		return nil, err
+	}
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	defer vm.arena.release(vm.arena.mark())
+	C.wrenGetVariable(vm.vm, vm.arena.cString(channelModule), vm.arena.cString("GoChannel"), C.int(base))
+	vm.newForeign(base+1, base, foreignInstance{value: &goChannel{ch: value}})
+	return &ForeignHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, C.int(base+1)))}, nil
+}
+
+// PendingReceives returns how many fibers are waiting to receive from Go channels
+func (vm *VM) PendingReceives() int {
+	return len(vm.receivers)
+}
+
+// PendingSends returns how many fibers are waiting for Go channels to take what they sent
+func (vm *VM) PendingSends() int {
+	return len(vm.senders)
+}
+
+// pollReceivers resumes every fiber whose channel has a value (or was closed) without waiting for the others
+func (vm *VM) pollReceivers() error {
+	waiting := vm.receivers
+	vm.receivers = nil
+	for i, r := range waiting {
+		value, ok := r.channel.ch.TryRecv()
+		if !value.IsValid() {
+			vm.receivers = append(vm.receivers, r)
+			continue
+		}
+		if err := vm.deliver(r, value, ok); err != nil {
+			vm.receivers = append(vm.receivers, waiting[i+1:]...)
+			return err
+		}
+	}
+	return nil
+}
+
+// pollSenders resumes every fiber whose channel took its value without waiting for the others. Fibers sending to a channel that was closed are aborted with `ChannelClosed`
+func (vm *VM) pollSenders() error {
+	waiting := vm.senders
+	vm.senders = nil
+	for i, s := range waiting {
+		sent, err := trySend(s.channel.ch, s.value)
+		if !sent && err == nil {
+			vm.senders = append(vm.senders, s)
+			continue
+		}
+		if err != nil {
+			err = vm.abortFiber(s.fiber, err)
+		} else {
+			err = vm.resume(s.fiber)
+		}
+		if err != nil {
+			vm.senders = append(vm.senders, waiting[i+1:]...)
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver resumes a fiber waiting in `receive()` with the value it received
+func (vm *VM) deliver(r receiver, value reflect.Value, ok bool) error {
+	return vm.resumeWith(r.fiber, received(value, ok))
+}
@@ -0,0 +1,82 @@
+package wren
+
+import (
+	"io/fs"
+	"strings"
+)
+
+// checkGuard is put in front of checked sources so interpreting them stops before running any of their code
+const checkGuard = "Fiber.suspend()"
+
+// checker compiles modules in a VM of its own, collecting the compile errors
+type checker struct {
+	vm          *VM
+	diagnostics []*CompileError
+	// how many lines the guard added before the source
+	offset int
+}
+
+func newChecker() *checker {
+	c := &checker{}
+	c.vm = (&Config{ErrorFn: func(_ *VM, err error) {
+		if diagnostic, ok := err.(*CompileError); ok {
+			diagnostic.line -= c.offset
+			c.diagnostics = append(c.diagnostics, diagnostic)
+		}
+	}}).NewVM()
+	return c
+}
+
+// check compiles `source` as the module `module`, returning `ResultCompileError` if it doesn't compile. Wren's API can only compile a module by interpreting it, so the source is interpreted with a guard that suspends the fiber before its first statement. A shebang is only skipped on the first line, so the guard goes in front of it on the same line
+func (c *checker) check(module, source string) error {
+	c.diagnostics = nil
+	c.offset = 0
+	if strings.HasPrefix(source, "#!/") {
+		source = checkGuard + " " + source
+	} else {
+		source = checkGuard + "\n" + source
+		c.offset = 1
+	}
+	if _, ok := c.vm.InterpretString(module, source).(*ResultCompileError); ok {
+		return &ResultCompileError{Diagnostics: c.diagnostics}
+	}
+	return nil
+}
+
+// CompileCheck compiles `source` as the module `module` without running any of it, such as to validate scripts in an editor or CI. If it doesn't compile, `ResultCompileError` is returned with every `CompileError` Wren found (they are not sent to `ErrorFn`). The source is compiled as a new module in a separate VM, so this VM's modules are not changed and variables from them can't be used. Since imports happen when a module runs, imported modules aren't checked
+func (vm *VM) CompileCheck(module, source string) error {
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	c := newChecker()
+	defer c.vm.Free()
+	return c.check(module, source)
+}
+
+// CheckAll compiles every file in `fsys` that matches `glob` (see `fs.Glob`) like `CompileCheck` does and returns the compile errors of all of them, in the order of the files. Each file is compiled as a module named after its path, so the errors tell which file they are from. It can be used to lint scripts in a Go test:
+//
+//	diagnostics, err := wren.CheckAll(os.DirFS("scripts"), "*.wren")
+//	for _, diagnostic := range diagnostics {
+//		t.Error(diagnostic)
+//	}
+//
+// The error is only set if the files couldn't be found or read
+func CheckAll(fsys fs.FS, glob string) ([]*CompileError, error) {
+	files, err := fs.Glob(fsys, glob)
+	if err != nil {
+		return nil, err
+	}
+	c := newChecker()
+	defer c.vm.Free()
+	var diagnostics []*CompileError
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		if err, ok := c.check(file, string(data)).(*ResultCompileError); ok {
+			diagnostics = append(diagnostics, err.Diagnostics...)
+		}
+	}
+	return diagnostics, nil
+}
@@ -0,0 +1,216 @@
+//go:build cgo
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// Go method names for operators, since they can't be used as names
+var operatorNames = map[string]string{
+	"+": "Add", "-": "Subtract", "*": "Multiply", "/": "Divide", "%": "Modulo",
+	"<": "Less", ">": "Greater", "<=": "LessEqual", ">=": "GreaterEqual", "==": "Equal", "!=": "NotEqual",
+	"&": "And", "|": "Or", "^": "Xor", "<<": "ShiftLeft", ">>": "ShiftRight",
+	"..": "Range", "...": "ExclusiveRange", "is": "Is",
+}
+
+var prefixOperatorNames = map[string]string{"-": "Negate", "!": "Not", "~": "Complement"}
+
+func bindgen(args []string) int {
+	flags := flag.NewFlagSet("bindgen", flag.ExitOnError)
+	pkg := flags.String("package", "main", "package of the generated file")
+	out := flags.String("o", "wren_bindings.go", "file to write the bindings to")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: wrengo bindgen [flags] files...\n\nEvery .wren file is a module named after the file. Its foreign classes and methods are bound to Go interfaces that the generated file declares.\n\nFlags:")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+	var src strings.Builder
+	fmt.Fprintf(&src, "// Code generated by \"wrengo bindgen\"; DO NOT EDIT.\n\npackage %v\n\nimport wren \"%v\"\n", *pkg, modulePath)
+	var modules []string
+	for _, file := range flags.Args() {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "wrengo: %v\n", err)
+			return 1
+		}
+		name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		writeModule(&src, name, file, string(source))
+		modules = append(modules, name)
+	}
+	writeBindings(&src, modules)
+	formatted, err := format.Source([]byte(src.String()))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "wrengo: generated invalid Go: %v\n", err)
+		return 1
+	}
+	if err := os.WriteFile(*out, formatted, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "wrengo: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// goName turns a Wren or file name into an exported Go name, such as "math_utils" into "MathUtils"
+func goName(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, c := range name {
+		switch {
+		case c == '_' || c == '-' || c == '.' || c == ' ':
+			upper = true
+		case upper:
+			b.WriteString(strings.ToUpper(string(c)))
+			upper = false
+		default:
+			b.WriteRune(c)
+		}
+	}
+	if b.Len() == 0 || b.String()[0] >= '0' && b.String()[0] <= '9' {
+		return "X" + b.String()
+	}
+	return b.String()
+}
+
+// boundMethod is a foreign method and the Go method it is bound to
+type boundMethod struct {
+	signature string
+	sig       *wren.Signature
+	name      string
+}
+
+// params returns the names of the Go parameters of a method, not counting the VM and receiver
+func (m boundMethod) params() []string {
+	params := make([]string, m.sig.Arity)
+	for i := range params {
+		params[i] = fmt.Sprintf("a%v", i)
+	}
+	switch m.sig.Kind {
+	case wren.SignatureSetter:
+		params = []string{"value"}
+	case wren.SignatureSubscriptSetter:
+		params = append(params, "value")
+	case wren.SignatureInfixOperator:
+		params = []string{"other"}
+	}
+	return params
+}
+
+// methodNames picks Go method names for the foreign methods of a class. If methods would have the same name, getters get "Get" added in front of their name (such as `name` becoming "GetName" next to `name()`) and the others get their arity added to it
+func methodNames(signatures []string) []boundMethod {
+	var methods []boundMethod
+	count := make(map[string]int)
+	for _, signature := range signatures {
+		sig, err := wren.ParseSignature(signature)
+		if err != nil {
+			continue
+		}
+		var name string
+		switch sig.Kind {
+		case wren.SignatureMethod, wren.SignatureGetter:
+			name = goName(sig.Name)
+		case wren.SignatureSetter:
+			name = "Set" + goName(sig.Name)
+		case wren.SignatureSubscript:
+			name = "Subscript"
+		case wren.SignatureSubscriptSetter:
+			name = "SetSubscript"
+		case wren.SignaturePrefixOperator:
+			name = prefixOperatorNames[sig.Name]
+		case wren.SignatureInfixOperator:
+			name = operatorNames[sig.Name]
+		}
+		count[staticName(sig, name)]++
+		methods = append(methods, boundMethod{signature: signature, sig: sig, name: name})
+	}
+	for i, m := range methods {
+		if count[staticName(m.sig, m.name)] > 1 {
+			if m.sig.Kind == wren.SignatureGetter {
+				methods[i].name = "Get" + m.name
+			} else {
+				methods[i].name = fmt.Sprintf("%v%v", m.name, m.sig.Arity)
+			}
+		}
+		methods[i].name = staticName(m.sig, methods[i].name)
+	}
+	return methods
+}
+
+// staticName adds "Static" in front of the name of a static method
+func staticName(sig *wren.Signature, name string) string {
+	if sig.Static {
+		return "Static" + name
+	}
+	return name
+}
+
+func writeModule(src *strings.Builder, module, file, source string) {
+	prefix := goName(module)
+	classes := wren.ParseDeclarations(source)
+	sourceName := strings.ToLower(prefix[:1]) + prefix[1:] + "Source"
+	fmt.Fprintf(src, "\n// %v is the source of %q\nconst %v = %q\n", sourceName, filepath.ToSlash(file), sourceName, source)
+	for _, class := range classes {
+		fmt.Fprintf(src, "\n// %v%v is implemented in Go for the class %v in the Wren module %q\ntype %v%v interface {\n", prefix, class.Name, class.Name, module, prefix, class.Name)
+		if class.Foreign {
+			fmt.Fprintf(src, "// Initialize is called by the constructors of %v and returns the Go value of the new instance. If it also has a `Finalize(vm *wren.VM, data interface{})` method, it is called when the instance is garbage collected\nInitialize(vm *wren.VM, parameters []interface{}) (interface{}, error)\n", class.Name)
+		}
+		for _, m := range methodNames(class.Methods) {
+			fmt.Fprintf(src, "// %v is bound to %q\n%v(vm *wren.VM, receiver interface{}", m.name, m.signature, m.name)
+			for _, param := range m.params() {
+				fmt.Fprintf(src, ", %v interface{}", param)
+			}
+			src.WriteString(") (interface{}, error)\n")
+		}
+		src.WriteString("}\n")
+	}
+	fmt.Fprintf(src, "\n// %vClasses holds the Go side of every class in the Wren module %q\ntype %vClasses struct {\n", prefix, module, prefix)
+	for _, class := range classes {
+		fmt.Fprintf(src, "%v %v%v\n", goName(class.Name), prefix, class.Name)
+	}
+	src.WriteString("}\n")
+	fmt.Fprintf(src, "\n// Module returns the module %q with its foreign methods bound to `classes`\nfunc (classes %vClasses) Module() *wren.Module {\nmodule := wren.NewModule(wren.ClassMap{})\n", module, prefix)
+	for _, class := range classes {
+		field := "classes." + goName(class.Name)
+		fmt.Fprintf(src, "{\nclass := wren.NewClass(nil, nil, wren.MethodMap{\n")
+		for _, m := range methodNames(class.Methods) {
+			fmt.Fprintf(src, "%q: func(vm *wren.VM, parameters []interface{}) (interface{}, error) {\nreturn %v.%v(vm, parameters[0]", m.signature, field, m.name)
+			for i := range m.params() {
+				fmt.Fprintf(src, ", parameters[%v]", i+1)
+			}
+			src.WriteString(")\n},\n")
+		}
+		src.WriteString("})\n")
+		if class.Foreign {
+			fmt.Fprintf(src, "class.Initializer = %v.Initialize\n", field)
+			fmt.Fprintf(src, "if finalizer, ok := %v.(interface{ Finalize(vm *wren.VM, data interface{}) }); ok {\nclass.Finalizer = finalizer.Finalize\n}\n", field)
+			fmt.Fprintf(src, "class.Constructors = %#v\n", class.Constructors)
+		}
+		fmt.Fprintf(src, "module.ClassMap[%q] = class\n}\n", class.Name)
+	}
+	fmt.Fprintf(src, "module.Source = %v\nreturn module\n}\n", sourceName)
+}
+
+func writeBindings(src *strings.Builder, modules []string) {
+	sort.Strings(modules)
+	src.WriteString("\n// Bindings holds the Go side of every generated module\ntype Bindings struct {\n")
+	for _, module := range modules {
+		fmt.Fprintf(src, "%v %vClasses\n", goName(module), goName(module))
+	}
+	src.WriteString("}\n\n// ModuleMap returns every generated module by its name, ready to be passed to `VM.Merge` or set one by one with `VM.SetModule`\nfunc (bindings Bindings) ModuleMap() wren.ModuleMap {\nreturn wren.ModuleMap{\n")
+	for _, module := range modules {
+		fmt.Fprintf(src, "%q: bindings.%v.Module(),\n", module, goName(module))
+	}
+	src.WriteString("}\n}\n")
+}
@@ -0,0 +1,10 @@
+//go:build cgo
+
+package main
+
+import wren "github.com/crazyinfin8/WrenGo"
+
+// builtVersion returns the version of Wren that wrengo was built with
+func builtVersion() string {
+	return wren.VersionString
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+const modulePath = "github.com/crazyinfin8/WrenGo"
+
+// checkResult is the outcome of one of doctor's checks. If `fix` is set, the check failed and `fix` explains what to do about it. Warnings don't make doctor fail
+type checkResult struct {
+	detail, fix string
+	warning     bool
+}
+
+type check struct {
+	name string
+	run  func() checkResult
+}
+
+func doctor(args []string) int {
+	flags := flag.NewFlagSet("doctor", flag.ExitOnError)
+	dir := flags.String("dir", "", "directory containing WrenGo's source (found with \"go list\" if not set)")
+	flags.Parse(args)
+
+	checks := []check{
+		{"go toolchain", checkGo},
+		{"cgo", checkCgo},
+		{"C compiler", checkCompiler},
+		{"wren sources", func() checkResult { return checkSources(*dir) }},
+		{"python", checkPython},
+	}
+	failed := false
+	for _, c := range checks {
+		result := c.run()
+		switch {
+		case result.fix == "":
+			fmt.Printf("[ok] %v: %v\n", c.name, result.detail)
+		case result.warning:
+			fmt.Printf("[??] %v: %v\n     %v\n", c.name, result.detail, result.fix)
+		default:
+			failed = true
+			fmt.Printf("[!!] %v: %v\n     %v\n", c.name, result.detail, result.fix)
+		}
+	}
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+func goEnv(name string) (string, error) {
+	output, err := exec.Command("go", "env", name).Output()
+	return strings.TrimSpace(string(output)), err
+}
+
+func checkGo() checkResult {
+	output, err := exec.Command("go", "version").Output()
+	if err != nil {
+		return checkResult{detail: "could not run \"go version\"", fix: "install Go from https://go.dev/dl and make sure it is on your PATH"}
+	}
+	return checkResult{detail: strings.TrimSpace(string(output))}
+}
+
+func checkCgo() checkResult {
+	enabled, err := goEnv("CGO_ENABLED")
+	if err != nil {
+		return checkResult{detail: "could not run \"go env CGO_ENABLED\"", fix: "install Go from https://go.dev/dl and make sure it is on your PATH"}
+	}
+	if enabled != "1" {
+		return checkResult{detail: "cgo is disabled", fix: "WrenGo is built with cgo. Set the environment variable CGO_ENABLED=1 (it is disabled by default when cross compiling)"}
+	}
+	return checkResult{detail: "enabled"}
+}
+
+func compilerFix() string {
+	switch runtime.GOOS {
+	case "windows":
+		return "install a MinGW-w64 gcc (for example through MSYS2 or TDM-GCC) and add its bin directory to your PATH"
+	case "darwin":
+		return "install the Xcode command line tools with \"xcode-select --install\""
+	default:
+		return "install gcc or clang with your package manager (for example \"apt install build-essential\" or \"apk add build-base\")"
+	}
+}
+
+func checkCompiler() checkResult {
+	// CC may contain flags after the compiler itself
+	compiler := "gcc"
+	if cc, err := goEnv("CC"); err == nil {
+		if fields := strings.Fields(cc); len(fields) > 0 {
+			compiler = fields[0]
+		}
+	}
+	path, err := exec.LookPath(compiler)
+	if err != nil {
+		return checkResult{detail: fmt.Sprintf("could not find the C compiler \"%v\"", compiler), fix: compilerFix()}
+	}
+	output, err := exec.Command(path, "--version").Output()
+	if err != nil {
+		return checkResult{detail: fmt.Sprintf("\"%v --version\" failed", path), fix: compilerFix()}
+	}
+	version, _ := bufio.NewReader(strings.NewReader(string(output))).ReadString('\n')
+	return checkResult{detail: strings.TrimSpace(version)}
+}
+
+// findSources looks for WrenGo's source directory, first in the current directory and then with "go list"
+func findSources(dir string) (string, error) {
+	if dir != "" {
+		return dir, nil
+	}
+	if data, err := os.ReadFile("go.mod"); err == nil && strings.Contains(string(data), "module "+modulePath) {
+		return ".", nil
+	}
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", modulePath).Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// headerVersion reads WREN_VERSION_STRING from wren.h
+func headerVersion(file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	lines := bufio.NewScanner(strings.NewReader(string(data)))
+	for lines.Scan() {
+		fields := strings.Fields(lines.Text())
+		if len(fields) == 3 && fields[0] == "#define" && fields[1] == "WREN_VERSION_STRING" {
+			return strings.Trim(fields[2], `"`), nil
+		}
+	}
+	return "", fmt.Errorf("%v does not define WREN_VERSION_STRING", file)
+}
+
+func checkSources(dir string) checkResult {
+	dir, err := findSources(dir)
+	if err != nil || dir == "" {
+		return checkResult{detail: "could not find WrenGo's source directory", fix: "run doctor inside a module that requires " + modulePath + ", or pass -dir", warning: true}
+	}
+	for _, name := range []string{"wren.c", "wren.h"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			return checkResult{detail: fmt.Sprintf("%v is missing from %v", name, dir), fix: "run \"go generate\" in " + dir + " to download Wren and create the amalgamation (requires git and python)"}
+		}
+	}
+	version, err := headerVersion(filepath.Join(dir, "wren.h"))
+	if err != nil {
+		return checkResult{detail: err.Error(), fix: "run \"go generate\" in " + dir + " to recreate wren.h"}
+	}
+	if built := builtVersion(); built != "" && version != built {
+		return checkResult{detail: fmt.Sprintf("wren.h is version %v but wrengo was built with %v", version, built), fix: "rebuild wrengo so that it matches the sources in " + dir, warning: true}
+	}
+	return checkResult{detail: fmt.Sprintf("Wren %v in %v", version, dir)}
+}
+
+// checkPython looks for the "python" command that getWren.go runs to create the amalgamation
+func checkPython() checkResult {
+	if path, err := exec.LookPath("python"); err == nil {
+		return checkResult{detail: path}
+	}
+	if path, err := exec.LookPath("python3"); err == nil {
+		return checkResult{detail: "found " + path + " but not \"python\"", fix: "\"go generate\" runs \"python\", so make it point to python3 (for example with the python-is-python3 package)", warning: true}
+	}
+	return checkResult{detail: "python was not found", fix: "python is only needed to regenerate wren.c with \"go generate\"", warning: true}
+}
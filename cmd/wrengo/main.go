@@ -0,0 +1,59 @@
+// Command wrengo is a small tool for working with Wren scripts and WrenGo
+//
+// Usage:
+//
+//	wrengo <command> [arguments]
+//
+// The commands are:
+//
+//	run     run a script with the standard library
+//	repl    evaluate Wren interactively
+//	check   report compile errors in Wren files without running them
+//	doctor  check that the tools needed to build WrenGo are set up correctly
+//	bindgen generate Go bindings for the foreign classes and methods in Wren files
+//
+// Only doctor works if wrengo is built without cgo (CGO_ENABLED=0), so it can tell why WrenGo doesn't build
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+type command struct {
+	name, description string
+	run               func(args []string) int
+}
+
+var commands = []command{
+	{"run", "run a script with the standard library", run},
+	{"repl", "evaluate Wren interactively", repl},
+	{"check", "report compile errors in Wren files without running them", checkFiles},
+	{"doctor", "check that the tools needed to build WrenGo are set up correctly", doctor},
+	{"bindgen", "generate Go bindings for the foreign classes and methods in Wren files", bindgen},
+}
+
+func usage() {
+	fmt.Fprintln(flag.CommandLine.Output(), "Usage: wrengo <command> [arguments]\n\nThe commands are:")
+	for _, cmd := range commands {
+		fmt.Fprintf(flag.CommandLine.Output(), "\t%-8v%v\n", cmd.name, cmd.description)
+	}
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	for _, cmd := range commands {
+		if cmd.name == flag.Arg(0) {
+			os.Exit(cmd.run(flag.Args()[1:]))
+		}
+	}
+	fmt.Fprintf(os.Stderr, "wrengo: unknown command \"%v\"\n", flag.Arg(0))
+	usage()
+	os.Exit(2)
+}
@@ -0,0 +1,26 @@
+//go:build !cgo
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// builtVersion returns nothing since wrengo wasn't built with WrenGo, so doctor doesn't compare versions
+func builtVersion() string {
+	return ""
+}
+
+// needsCgo stands in for the commands that use WrenGo, which can't be built without cgo. Only doctor is available then, to find out why
+func needsCgo(args []string) int {
+	fmt.Fprintln(os.Stderr, "wrengo: this command needs wrengo to be built with cgo. Run \"wrengo doctor\" to see what is missing")
+	return 1
+}
+
+var (
+	run        = needsCgo
+	repl       = needsCgo
+	checkFiles = needsCgo
+	bindgen    = needsCgo
+)
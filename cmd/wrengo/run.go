@@ -0,0 +1,145 @@
+//go:build cgo
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	wren "github.com/crazyinfin8/WrenGo"
+	"github.com/crazyinfin8/WrenGo/stdlib"
+)
+
+// Exit codes for scripts that fail, the same ones the Wren CLI uses
+const (
+	exitCompileError = 65
+	exitRuntimeError = 70
+)
+
+// scriptFlags are the flags shared by run and repl
+type scriptFlags struct {
+	capabilities string
+	paths        string
+}
+
+func (f *scriptFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.capabilities, "caps", "io,os", "comma separated capabilities granted to scripts (such as \"io\" to let them import \"io\")")
+	flags.StringVar(&f.paths, "path", "", "list of extra directories to load modules from, separated like $PATH")
+}
+
+// newVM creates a VM with the standard library, "timer" and "json" modules. Modules are loaded from `dir` and then the directories given with -path. Scripts get `args` as `Process.arguments`
+func (f *scriptFlags) newVM(dir string, args []string) *wren.VM {
+	cfg := wren.NewConfig()
+	for _, capability := range strings.Split(f.capabilities, ",") {
+		if capability = strings.TrimSpace(capability); capability != "" {
+			cfg.Capabilities = append(cfg.Capabilities, wren.Capability(capability))
+		}
+	}
+	dirs := []string{dir}
+	if f.paths != "" {
+		dirs = append(dirs, filepath.SplitList(f.paths)...)
+	}
+	cfg.LoadModuleFn = wren.SearchPathLoader(dirs...)
+	vm := cfg.NewVM()
+	modules := stdlib.ModuleMap(cfg.Capabilities...)
+	if _, ok := modules["process"]; ok {
+		modules["process"] = stdlib.NewProcessModule(args)
+	}
+	modules["timer"] = wren.NewTimerModule()
+	modules["json"] = wren.NewJSONModule()
+	vm.Merge(modules)
+	return vm
+}
+
+// exitCode is what wrengo exits with after a script fails with `err`. Compile and runtime errors were already printed by the VM
+func exitCode(err error) int {
+	var compileError *wren.ResultCompileError
+	var runtimeError *wren.ResultRuntimeError
+	switch {
+	case err == nil:
+		return 0
+	case errors.As(err, &compileError):
+		return exitCompileError
+	case errors.As(err, &runtimeError):
+		return exitRuntimeError
+	}
+	fmt.Fprintf(os.Stderr, "wrengo: %v\n", err)
+	return 1
+}
+
+func run(args []string) int {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	var f scriptFlags
+	f.register(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: wrengo run [flags] script.wren [arguments...]\n\nRuns a script, and then the fibers it left waiting on timers or input, until they are done. The arguments are passed to the script as Process.arguments. Modules are imported from the script's directory.\n\nFlags:")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+	script := flags.Arg(0)
+	vm := f.newVM(filepath.Dir(script), flags.Args()[1:])
+	defer vm.Free()
+	if err := vm.InterpretFile(script); err != nil {
+		return exitCode(err)
+	}
+	return exitCode(vm.Run())
+}
+
+func repl(args []string) int {
+	flags := flag.NewFlagSet("repl", flag.ExitOnError)
+	var f scriptFlags
+	f.register(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: wrengo repl [flags] [arguments...]\n\nReads Wren from standard input a line at a time and prints the value of each expression. Modules are imported from the current directory.\n\nFlags:")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	vm := f.newVM(".", flags.Args())
+	defer vm.Free()
+	if err := wren.NewREPL(vm, "repl").Run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "wrengo: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func checkFiles(args []string) int {
+	flags := flag.NewFlagSet("check", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: wrengo check files...\n\nCompiles each file without running it and prints the compile errors. Imported modules aren't checked unless they are given too.")
+	}
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+	vm := wren.NewConfig().NewVM()
+	defer vm.Free()
+	code := 0
+	for _, file := range flags.Args() {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "wrengo: %v\n", err)
+			code = 1
+			continue
+		}
+		var compileError *wren.ResultCompileError
+		if err := vm.CompileCheck(file, string(source)); errors.As(err, &compileError) {
+			for _, diagnostic := range compileError.Diagnostics {
+				fmt.Fprintln(os.Stderr, diagnostic)
+			}
+			if code == 0 {
+				code = exitCompileError
+			}
+		}
+	}
+	return code
+}
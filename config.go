@@ -4,35 +4,130 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 )
 
 // Config contains some settings to setup how VM will behave
 type Config struct {
+	// Name identifies the VM, for example in `AuditEvent`s
+	Name string
 	// Wren calls this function to print text
 	WriteFn WriteFn
+	// If set, text that scripts print is split into lines and passed here instead of `WriteFn`. Text after the last newline is held until the line is finished or the interpretation or call returns
+	LineWriter LineWriter
 	// Wren calls this function to print errors
 	ErrorFn ErrorFn
-	// Wren calls this function before loading modules to resolve module names.
+	// Wren calls this function before loading modules to resolve module names. If it is not set, `DefaultModuleResolver` is used instead
 	ResolveModuleFn ResolveModuleFn
+	// Wren calls this function to import modules before `LoadModuleFn`. The module it returns is used like one set with `SetModule`, so its foreign classes are bound when its source is interpreted
+	ModuleProviderFn ModuleProviderFn
 	// Wren calls this function to import modules (if you want to disable importing, this should be set to nil and the global value `DefaultModuleLoader` should also be set to nil)
 	LoadModuleFn LoadModuleFn
 	// If `WriteFn` is not set, wren will print text to here instead (if you want to disable all output, this should be set to nil and the global value `DefaultOutput` should also be set to nil)
 	DefaultOutput io.Writer
 	// If `ErrorFn` is not set, wren errors will be written to here instead (if you want to disable all output, this should be set to nil and the global value `DefaultError` should also be set to nil)
 	DefaultError io.Writer
+	// Scripts using the module from `NewStdinModule` read input from here (if you want to disable all input, this should be set to nil and the global value `DefaultInput` should also be set to nil)
+	DefaultInput io.Reader
+	// If set, every import and foreign method call that scripts make is recorded here
+	AuditSink AuditSink
+	// Capabilities granted to scripts. Optional modules registered with `RegisterOptionalModule` can only be imported if their capability is granted
+	Capabilities []Capability
+	// If set, restricts what scripts may import and which foreign classes they may construct
+	Sandbox *SandboxPolicy
+	// The most fuel a single interpretation or call may use before it is aborted with `BudgetExceeded`. Scripts use one unit of fuel for every loop iteration and method call. 0 means there is no limit. See `BudgetExceeded` for what fuel can and can't limit
+	Fuel int64
+	// If set, this is called every time a script uses fuel with the amount used so far (including this unit). If it returns false, the script is aborted with `BudgetExceeded`. This can be used for budgets based on something else, like time or memory. It is called for every loop iteration and method call, so it should be quick
+	FuelFn func(vm *VM, used int64) bool
+	// The longest a single interpretation or call may run before it is aborted with `TimeoutError`. Scripts are stopped at their next loop iteration or method call (see `InterpretStringContext`). 0 means there is no limit
+	MaxExecutionTime time.Duration
+	// A soft limit on the memory in bytes a VM may use. Wren can't recover from a failed allocation, so allocations are never refused. Instead a script that goes over the limit is aborted with `OutOfMemory` at its next loop iteration or method call (or when it returns) if collecting garbage doesn't bring it back under. A single allocation, such as `List.filled(1e9, 0)`, can still take the process far past the limit before then, so this doesn't protect the host from running out of memory. 0 means there is no limit
+	MaxHeapBytes int64
+	// The longest source code in bytes that `InterpretReader`, `InterpretFile` and `InterpretFileFS` will read. 0 means there is no limit
+	MaxSourceBytes int64
+	// If set, this is called whenever the VM allocates, resizes, or frees memory. It is read when the VM is created
+	ReallocateFn ReallocateFn
+	// If true, Go slices, arrays, and maps are not converted into new Wren lists and maps when they are passed to Wren and `InvalidValue` is returned instead
+	StrictValues bool
+	// If true, `InterpretString` and `CallHandle.Call` (and what uses them, like `VM.CallStatic`) don't return `RunningVMError` when they are used while the VM is running, such as from a foreign method. The call is queued instead and made once the interpretation or call that is running returns, and nil is returned right away. Errors from queued calls are sent to `ErrorFn`. Handles passed to a queued call should not be freed before it is made. Other calls into Wren, like `VM.Call` and the methods of handles, return `ReentrantCallError` instead
+	QueueReentrantCalls bool
+	// If true, the VM is created on a goroutine locked to an OS thread of its own and every method of the VM and its handles that uses Wren runs there, so foreign methods always run on the same thread (such as for libraries that are tied to one thread) and calls from many goroutines are run one at a time. `VM.Do` runs several of them at once on the thread. The thread ends when the VM is freed
+	PinToThread bool
+	// If true, handles don't have to be freed and are released some time after Go garbage collects them instead. Freeing them is still allowed and releases them sooner. Since Go decides when to collect garbage, the Wren objects they hold may stay alive for a while after they are no longer used
+	AutoFreeHandles bool
+	// If set, this is called after every foreign method call with how long it took. It is read when foreign methods are bound
+	MethodObserver MethodObserver
+	// If set, these are called before and after every interpretation with the name of the module being interpreted, such as to start and end a tracing span
+	BeforeInterpret func(vm *VM, module string)
+	AfterInterpret  func(vm *VM, module string, err error)
+	// If set, these are called before and after every call into Wren from Go with the signature of the method being called
+	BeforeCall func(vm *VM, signature string)
+	AfterCall  func(vm *VM, signature string, err error)
+	// If set, this is called whenever a foreign method or finalizer panics. Either way, the panic is recovered and the fiber that called the method is aborted with `ForeignPanic`
+	PanicHandler PanicHandler
+	// If true, scripts can import Wren's optional "meta" module to compile and run code from strings with `Meta.eval` and `Meta.compile`. It is disabled by default since it lets scripts run code that wasn't imported. Compile errors from `Meta.eval` are sent to `ErrorFn` and it aborts with `EvalError`
+	EnableMeta bool
+	// If true, scripts can't import Wren's optional "random" module, such as for sandboxed VMs that should be deterministic
+	DisableRandom bool
+	// Wren source code that is interpreted in order whenever a VM is created from this config, so helpers and foreign class declarations are available to every script
+	Preludes []Prelude
 	// Custom data
 	UserData interface{}
 }
 
+// Prelude is Wren source code that is interpreted into the module `Module` when a VM is created
+type Prelude struct {
+	Module, Source string
+}
+
+// PreludeError is sent to `ErrorFn` if one of the config's `Preludes` failed to interpret. Preludes after the one that failed are not interpreted
+type PreludeError struct {
+	Module string
+	Err    error
+}
+
+func (err *PreludeError) Error() string {
+	return fmt.Sprintf("Prelude for module \"%v\" failed: %v", err.Module, err.Err)
+}
+
+// Unwrap returns the error that the prelude failed with
+func (err *PreludeError) Unwrap() error {
+	return err.Err
+}
+
 // WriteFn is called by wren whenever `System.write`, `System.print`, or `System.printAll` is called in a script
 type WriteFn func(vm *VM, text string)
 
+// LineWriter is called with every line that a script prints, without its newline
+type LineWriter func(vm *VM, line string)
+
 // ErrorFn is called by Wren whenever there is a runtime error, compile error, or stack trace. It should be of type `CompileError`, `RuntimeError`, or `StackTrace`
 type ErrorFn func(vm *VM, err error)
 
 // ResolveModuleFn is called by wren whenever `import` is called but runs before LoadModuleFn. It takes the file that called the import as well as the name of the mofule to import and returns a string that will then be put into ResolveModule. If modules name cannot be resolved, setting `ok` to false will send an error to the VM
 type ResolveModuleFn func(vm *VM, importer, name string) (newName string, ok bool)
 
+// ModuleProviderFn is called by Wren whenever `import` is called. Unlike `LoadModuleFn` it returns a whole `Module`, so modules that are found while the VM runs (such as plugins) can bring their foreign classes along with their source. If the module cannot be provided, setting `ok` to false falls back to `LoadModuleFn`
+type ModuleProviderFn func(vm *VM, name string) (module *Module, ok bool)
+
+// ReallocateFn is called by Wren whenever it allocates memory (`oldSize` is 0), resizes it, or frees it (`newSize` is 0), which is useful for profiling how much memory scripts use. Sizes are in bytes. It is called in the middle of Wren running and collecting garbage, so it must not call back into the VM. The first allocations happen while the VM is being created, before `NewVM` returns
+type ReallocateFn func(vm *VM, oldSize, newSize int)
+
+// PanicHandler is called if a foreign method or finalizer panics
+type PanicHandler func(vm *VM, err *ForeignPanic)
+
+// ForeignPanic is what a fiber is aborted with if a foreign method panics
+type ForeignPanic struct {
+	// The value passed to `panic`
+	Value interface{}
+	// The stack of the goroutine when it panicked
+	Stack []byte
+}
+
+func (err *ForeignPanic) Error() string {
+	return fmt.Sprintf("Foreign method panicked: %v", err.Value)
+}
+
 // LoadModuleFn is called by Wren whenever `import` is called. It takes the name of a module and returns the modules source code. If the module cannot be loaded, setting `ok` to false will send an error to the VM
 type LoadModuleFn func(vm *VM, name string) (source string, ok bool)
 
@@ -46,15 +141,36 @@ func (err *CompileError) Error() string {
 	return fmt.Sprintf("[%v line %v] %v", err.module, err.line, err.message)
 }
 
+// Module returns the name of the module that failed to compile
+func (err *CompileError) Module() string {
+	return err.module
+}
+
+// Line returns the line the error is on
+func (err *CompileError) Line() int {
+	return err.line
+}
+
+// Message returns what went wrong without the module and line
+func (err *CompileError) Message() string {
+	return err.message
+}
+
 // RuntimeError is sent by Wren to `ErrorFn` if the vm encountered an error during script execution
 type RuntimeError struct {
 	message string
+	value   interface{}
 }
 
 func (err *RuntimeError) Error() string {
 	return err.message
 }
 
+// Value returns what the fiber was aborted with, such as the object passed to `Fiber.abort`. Strings are returned as they are. Lists and maps are converted into Go slices and maps (see `ListHandle.ToSlice`) once the interpretation or call returns, so they are only handles while the error is passed to `ErrorFn`. Other objects are returned as handles that are released once Go garbage collects them, so they don't have to be freed
+func (err *RuntimeError) Value() interface{} {
+	return err.value
+}
+
 // StackTrace is sent by Wren to `ErrorFn` after sending `RuntimeError` these help try to pinpoint how and where an error occurred
 type StackTrace struct {
 	module, message string
@@ -67,7 +183,7 @@ func (err *StackTrace) Error() string {
 
 // NewConfig creates a new config and initializes it with default variables (mainly specifying where output should go)
 func NewConfig() *Config {
-	return &Config{DefaultOutput: os.Stdout, DefaultError: os.Stderr}
+	return &Config{DefaultOutput: os.Stdout, DefaultError: os.Stderr, DefaultInput: os.Stdin}
 }
 
 // Clone returns a copy of a config
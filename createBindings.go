@@ -10,7 +10,9 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
+	"path/filepath"
 	"text/template"
 )
 
@@ -23,49 +25,30 @@ package wren
 #cgo CFLAGS:
 #cgo LDFLAGS: -lm
 #include "wren.h"
-
-{{range .}}extern void f{{.}}(WrenVM* vm);
-{{end}}
-static inline WrenForeignMethodFn get_f(int i) {
-	switch (i) {
-		{{range .}}case {{.}}: return f{{.}};
-		{{end}}default: return (void*)(0);
-	}
-}
 */
 import "C"
 import (
 	"fmt"
 )
 
-const MAX_REGISTRATIONS = {{len .}}
+// MAX_REGISTRATIONS is how many foreign methods and classes a single VM can bind. It is set with the "-bindings" flag of createBindings.go
+const MAX_REGISTRATIONS = {{.Count}}
 
-{{ range . -}}
-//export f{{.}}
-func f{{.}}(v *C.WrenVM) {
-	if vm, ok := vmMap[v]; ok {
-		if len(vm.bindMap) > {{.}} {
-			params := vm.getAllSlots()
-			// println(params)
-			ret, err := vm.bindMap[{{.}}](vm, params)
-			if err != nil {
-				vm.Abort(err)
-			}
-			if ret != nil {
-				vm.setSlotValue(ret, 0)
-			}
-			vm.FreeAll(params)
-		}
-	}
-}
+// bindingsPerChunk is how many trampolines each generated bindings file holds
+const bindingsPerChunk = {{.ChunkSize}}
 
-{{end -}}
+// bindingChunks gets the trampoline for a binding index from the file that holds it
+var bindingChunks = [...]func(index int) C.WrenForeignMethodFn{
+	{{range .Chunks}}bindingChunk{{.}},
+	{{end}}}
+
+// MaxBindingsReached is sent to ` + "`ErrorFn`" + ` if a VM binds more foreign methods and classes than there are trampolines for. Wren then aborts with an error that the method could not be found
 type MaxBindingsReached struct {
 	VM *VM
 }
 
 func (err *MaxBindingsReached) Error() string {
-	return fmt.Sprintf("Cannot bind more that %v functions or classes", MAX_REGISTRATIONS)
+	return fmt.Sprintf("Cannot bind more than %v functions or classes (regenerate bindings with a higher -bindings count to raise the limit)", MAX_REGISTRATIONS)
 }
 
 func (vm *VM) registerFunc(fn ForeignMethodFn) (C.WrenForeignMethodFn, error) {
@@ -74,30 +57,116 @@ func (vm *VM) registerFunc(fn ForeignMethodFn) (C.WrenForeignMethodFn, error) {
 		return nil, &MaxBindingsReached{VM: vm}
 	}
 	vm.bindMap = append(vm.bindMap, fn)
-	return C.get_f(C.int(index)), nil
+	return bindingChunks[index/bindingsPerChunk](index), nil
+}
+
+// callForeign runs the foreign method bound at ` + "`index`" + `. Its parameters stay in the slots below the ones handles can reserve while it runs so they don't overwrite them
+func (vm *VM) callForeign(index int) {
+	if len(vm.bindMap) <= index {
+		return
+	}
+	params := vm.getAllSlots()
+	base := vm.slotTop
+	vm.slotTop = len(params)
+	ret, err := vm.runForeign(vm.bindMap[index], params)
+	vm.slotTop = base
+	if err != nil {
+		vm.Abort(err)
+	}
+	if ret != nil {
+		vm.setSlotValue(ret, 0)
+	}
+	vm.FreeAll(params)
+}
+`))
+
+var chunkTemplate = template.Must(template.New("").Parse(
+	`// Code generated by go generate; DO NOT EDIT.
+
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+
+{{range .Indices}}extern void f{{.}}(WrenVM* vm);
+{{end}}
+static inline WrenForeignMethodFn get_f{{.Chunk}}(int i) {
+	switch (i) {
+		{{range .Indices}}case {{.}}: return f{{.}};
+		{{end}}default: return (void*)(0);
+	}
 }
+*/
+import "C"
+
+// bindingChunk{{.Chunk}} returns the trampoline for the binding at ` + "`index`" + `
+func bindingChunk{{.Chunk}}(index int) C.WrenForeignMethodFn {
+	return C.get_f{{.Chunk}}(C.int(index))
+}
+
+{{ range .Indices -}}
+//export f{{.}}
+func f{{.}}(v *C.WrenVM) {
+	if vm, ok := vmFromC(v); ok {
+		vm.callForeign({{.}})
+	}
+}
+
+{{end -}}
 `))
 
+type bindings struct {
+	Count, ChunkSize int
+	Chunks           []int
+}
+
+type chunk struct {
+	Chunk   int
+	Indices []int
+}
+
+func execute(name string, t *template.Template, data interface{}) {
+	f, err := os.Create(name)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	if err := t.Execute(f, data); err != nil {
+		panic(err)
+	}
+}
+
 func main() {
-	var count int
+	var count, chunkSize int
 	flag.IntVar(&count, "bindings", 0, "Amount of bindings to create for wrenGo (bindings should be greater than 0)")
+	flag.IntVar(&chunkSize, "chunk", 128, "Amount of bindings to put in each generated file")
 	flag.Parse()
-	if count <= 0 {
+	if count <= 0 || chunkSize <= 0 {
 		flag.Usage()
 		return
 	}
 
-	f, err := os.Create("bindings.go")
+	// remove chunks from before in case there are fewer now
+	old, err := filepath.Glob("bindings_*.go")
 	if err != nil {
 		panic(err)
 	}
-	defer f.Close()
-
-	data := make([]int, count)
-	for i := 0; i < count; i++ {
-		data[i] = i
+	for _, name := range old {
+		if err := os.Remove(name); err != nil {
+			panic(err)
+		}
 	}
-	if err := fileTemplate.Execute(f, data); err != nil {
-		panic(err)
+
+	data := bindings{Count: count, ChunkSize: chunkSize}
+	for start := 0; start < count; start += chunkSize {
+		c := chunk{Chunk: len(data.Chunks)}
+		for i := start; i < start+chunkSize && i < count; i++ {
+			c.Indices = append(c.Indices, i)
+		}
+		execute(fmt.Sprintf("bindings_%v.go", c.Chunk), chunkTemplate, c)
+		data.Chunks = append(data.Chunks, c.Chunk)
 	}
+	execute("bindings.go", fileTemplate, data)
 }
@@ -0,0 +1,195 @@
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+*/
+import "C"
+import (
+	"reflect"
+	"time"
+)
+
+// DateTimeModule is the module with the `DateTime` class. Scripts use it with `import "wrengo/datetime" for DateTime`. It is only defined once a script imports it or Go creates a `DateTime` object
+const DateTimeModule = "wrengo/datetime"
+
+// dateTimeOf returns the `time.Time` a `DateTime` object holds
+func dateTimeOf(value interface{}) (time.Time, error) {
+	handle, ok := value.(*ForeignHandle)
+	if !ok {
+		return time.Time{}, &TypeMismatch{Expected: reflect.TypeOf(time.Time{}), Got: reflect.TypeOf(value)}
+	}
+	return ForeignAs[time.Time](handle)
+}
+
+// dateTimeMethod creates a method of `DateTime` that is given its receiver's time and the rest of its parameters
+func dateTimeMethod(fn func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error)) ForeignMethodFn {
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		t, err := dateTimeOf(parameters[0])
+		if err != nil {
+			return nil, err
+		}
+		return fn(vm, t, parameters[1:])
+	}
+}
+
+// dateTimeGetter creates a getter of `DateTime`
+func dateTimeGetter(fn func(t time.Time) interface{}) ForeignMethodFn {
+	return dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+		return fn(t), nil
+	})
+}
+
+// dateTimeCompare creates a comparison operator of `DateTime`
+func dateTimeCompare(fn func(a, b time.Time) bool) ForeignMethodFn {
+	return dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+		other, err := dateTimeOf(parameters[0])
+		if err != nil {
+			return nil, err
+		}
+		return fn(t, other), nil
+	})
+}
+
+// integers converts every parameter into an int
+func integers(vm *VM, parameters []interface{}) ([]int, error) {
+	values := make([]int, len(parameters))
+	for i := range parameters {
+		value, err := decodeArg[int](vm, parameters, i)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// dateTimeModuleDefinition declares the `DateTime` class. Times are created in the local time zone unless a zone is given, and durations are in seconds
+func dateTimeModuleDefinition() *Module {
+	module := NewModule(ClassMap{
+		"DateTime": {
+			Initializer: func(vm *VM, parameters []interface{}) (interface{}, error) {
+				values, err := integers(vm, parameters[1:])
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, make([]int, 6-len(values))...)
+				return time.Date(values[0], time.Month(values[1]), values[2], values[3], values[4], values[5], 0, time.Local), nil
+			},
+			Constructors: []string{"new(_,_,_)", "new(_,_,_,_,_,_)"},
+			MethodMap: MethodMap{
+				"static now": Method0(func(vm *VM) (interface{}, error) {
+					return time.Now(), nil
+				}),
+				"static unix(_)": Method1(func(vm *VM, seconds float64) (interface{}, error) {
+					return time.Unix(0, int64(seconds*float64(time.Second))), nil
+				}),
+				"static parse(_,_)": Method2(func(vm *VM, layout, text string) (interface{}, error) {
+					return time.ParseInLocation(layout, text, time.Local)
+				}),
+				"static parse(_,_,_)": Method3(func(vm *VM, layout, text, zone string) (interface{}, error) {
+					location, err := time.LoadLocation(zone)
+					if err != nil {
+						return nil, err
+					}
+					return time.ParseInLocation(layout, text, location)
+				}),
+				"static rfc3339":  Method0(func(vm *VM) (interface{}, error) { return time.RFC3339, nil }),
+				"static rfc1123":  Method0(func(vm *VM) (interface{}, error) { return time.RFC1123, nil }),
+				"static kitchen":  Method0(func(vm *VM) (interface{}, error) { return time.Kitchen, nil }),
+				"static dateOnly": Method0(func(vm *VM) (interface{}, error) { return "2006-01-02", nil }),
+				"static timeOnly": Method0(func(vm *VM) (interface{}, error) { return "15:04:05", nil }),
+
+				"year":       dateTimeGetter(func(t time.Time) interface{} { return t.Year() }),
+				"month":      dateTimeGetter(func(t time.Time) interface{} { return int(t.Month()) }),
+				"day":        dateTimeGetter(func(t time.Time) interface{} { return t.Day() }),
+				"hour":       dateTimeGetter(func(t time.Time) interface{} { return t.Hour() }),
+				"minute":     dateTimeGetter(func(t time.Time) interface{} { return t.Minute() }),
+				"second":     dateTimeGetter(func(t time.Time) interface{} { return t.Second() }),
+				"nanosecond": dateTimeGetter(func(t time.Time) interface{} { return t.Nanosecond() }),
+				"weekday":    dateTimeGetter(func(t time.Time) interface{} { return int(t.Weekday()) }),
+				"yearDay":    dateTimeGetter(func(t time.Time) interface{} { return t.YearDay() }),
+				"unix":       dateTimeGetter(func(t time.Time) interface{} { return float64(t.UnixNano()) / float64(time.Second) }),
+				"unixMilli":  dateTimeGetter(func(t time.Time) interface{} { return t.UnixNano() / int64(time.Millisecond) }),
+				"zone":       dateTimeGetter(func(t time.Time) interface{} { name, _ := t.Zone(); return name }),
+				"offset":     dateTimeGetter(func(t time.Time) interface{} { _, offset := t.Zone(); return offset }),
+				"utc":        dateTimeGetter(func(t time.Time) interface{} { return t.UTC() }),
+				"local":      dateTimeGetter(func(t time.Time) interface{} { return t.Local() }),
+				"toString":   dateTimeGetter(func(t time.Time) interface{} { return t.Format(time.RFC3339Nano) }),
+				"inZone(_)": dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+					zone, err := decodeArg[string](vm, parameters, 0)
+					if err != nil {
+						return nil, err
+					}
+					location, err := time.LoadLocation(zone)
+					if err != nil {
+						return nil, err
+					}
+					return t.In(location), nil
+				}),
+				"format(_)": dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+					layout, err := decodeArg[string](vm, parameters, 0)
+					if err != nil {
+						return nil, err
+					}
+					return t.Format(layout), nil
+				}),
+				"add(_)": dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+					seconds, err := decodeArg[float64](vm, parameters, 0)
+					if err != nil {
+						return nil, err
+					}
+					return t.Add(time.Duration(seconds * float64(time.Second))), nil
+				}),
+				"addDate(_,_,_)": dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+					values, err := integers(vm, parameters)
+					if err != nil {
+						return nil, err
+					}
+					return t.AddDate(values[0], values[1], values[2]), nil
+				}),
+				"-(_)": dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+					other, err := dateTimeOf(parameters[0])
+					if err != nil {
+						return nil, err
+					}
+					return t.Sub(other).Seconds(), nil
+				}),
+				"==(_)": dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+					other, err := dateTimeOf(parameters[0])
+					return err == nil && t.Equal(other), nil
+				}),
+				"!=(_)": dateTimeMethod(func(vm *VM, t time.Time, parameters []interface{}) (interface{}, error) {
+					other, err := dateTimeOf(parameters[0])
+					return err != nil || !t.Equal(other), nil
+				}),
+				"<(_)":  dateTimeCompare(func(a, b time.Time) bool { return a.Before(b) }),
+				">(_)":  dateTimeCompare(func(a, b time.Time) bool { return a.After(b) }),
+				"<=(_)": dateTimeCompare(func(a, b time.Time) bool { return !a.After(b) }),
+				">=(_)": dateTimeCompare(func(a, b time.Time) bool { return !a.Before(b) }),
+			},
+		},
+	})
+	module.Declare = true
+	return module
+}
+
+// NewDateTime creates a `DateTime` object holding `t`. Go `time.Time` values are turned into `DateTime` objects this way whenever they are passed to Wren, so this is only needed to keep a handle to one. Like `NewFn`, this can be used while the VM is running once `DateTimeModule` was defined, otherwise `ModuleNotImported` is returned
+func (vm *VM) NewDateTime(t time.Time) (handle *ForeignHandle, err error) {
+	if vm.onThread(func() { handle, err = vm.NewDateTime(t) }) {
+		return handle, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
+	}
+	if err := vm.requireModule(DateTimeModule, dateTimeModuleDefinition); err != nil {
+		return nil, err
+	}
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	defer vm.arena.release(vm.arena.mark())
+	C.wrenGetVariable(vm.vm, vm.arena.cString(DateTimeModule), vm.arena.cString("DateTime"), C.int(base))
+	vm.newForeign(base+1, base, foreignInstance{value: t})
+	return &ForeignHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, C.int(base+1)))}, nil
+}
@@ -0,0 +1,94 @@
+package wren
+
+import (
+	"fmt"
+	"strings"
+)
+
+// methodKey identifies a foreign method the way Wren asks for it when binding
+type methodKey struct {
+	module, class, signature string
+	static                   bool
+}
+
+// setModule clones `module` into the VM's modules and adds its methods to the dispatch table, replacing any methods the module had before
+func (vm *VM) setModule(name string, module *Module) {
+	vm.moduleMap[name] = module.Clone()
+	vm.indexModule(name)
+}
+
+// indexModule rebuilds the entries of the dispatch table for the module `name`, so binding a foreign method only takes a single lookup
+func (vm *VM) indexModule(name string) {
+	for key := range vm.methods {
+		if key.module == name {
+			delete(vm.methods, key)
+		}
+	}
+	module, ok := vm.moduleMap[name]
+	if !ok || module == nil {
+		return
+	}
+	for className, class := range module.ClassMap {
+		if class == nil {
+			continue
+		}
+		for signature, fn := range class.MethodMap {
+			key := methodKey{module: name, class: className, signature: strings.TrimPrefix(signature, "static ")}
+			key.static = len(key.signature) != len(signature)
+			// keys are stored the way Wren asks for them when binding
+			if sig, err := ParseSignature(signature); err == nil {
+				sig.Static = false
+				key.signature = sig.String()
+			}
+			vm.methods[key] = fn
+		}
+	}
+}
+
+// lookupMethod finds the foreign method Wren is binding and the name it has in its `MethodMap`
+func (vm *VM) lookupMethod(key methodKey) (ForeignMethodFn, string, bool) {
+	fn, ok := vm.methods[key]
+	if !ok {
+		return nil, "", false
+	}
+	if key.static {
+		return fn, "static " + key.signature, true
+	}
+	return fn, key.signature, true
+}
+
+// NoSuchMethod is returned from `RebindMethod` if the method isn't in a module set in the VM
+type NoSuchMethod struct {
+	Module, Class, Signature string
+}
+
+func (err *NoSuchMethod) Error() string {
+	return fmt.Sprintf("No method \"%v\" in class \"%v\" of module \"%v\"", err.Signature, err.Class, err.Module)
+}
+
+// RebindMethod replaces the Go function of a foreign method that was set with `SetModule`. Unlike setting the module again, this also changes the method if a script already declared it, so behavior can be patched while the VM keeps running. It can't add methods that weren't in the module before
+func (vm *VM) RebindMethod(module, class, signature string, fn ForeignMethodFn) (err error) {
+	if vm.onThread(func() { err = vm.RebindMethod(module, class, signature, fn) }) {
+		return err
+	}
+	if fn == nil {
+		return &InvalidValue{Value: fn}
+	}
+	sig, err := ParseSignature(signature)
+	if err != nil {
+		return err
+	}
+	key := methodKey{module: module, class: class, static: sig.Static}
+	sig.Static = false
+	key.signature = sig.String()
+	if _, ok := vm.methods[key]; !ok {
+		return &NoSuchMethod{Module: module, Class: class, Signature: signature}
+	}
+	_, name, _ := vm.lookupMethod(key)
+	vm.methods[key] = fn
+	vm.moduleMap[module].ClassMap[class].MethodMap[name] = fn
+	if index, ok := vm.bound[key]; ok {
+		vm.bindMap[index] = vm.instrument(module, class, name, fn)
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package wren
+
+import (
+	"reflect"
+)
+
+// ValueEqual structurally compares two values where either may be a Wren value (as returned by WrenGo) or a Go value. Lists are compared with `ListHandle`s, Go slices or arrays element by element, maps are compared with `MapHandle`s or Go maps key by key, and numbers are compared as float64 no matter their Go type. `ForeignHandle`s are compared by the Go value they hold and `RangeHandle`s are compared as the list of numbers they step through. Generic `Handle`s, `FiberHandle`s, `ClassHandle`s, and `FnHandle`s can't be compared and return an error. This is mostly meant for tests.
+func ValueEqual(a, b interface{}) (bool, error) {
+	// keep the Wren value (if there is one) on the left
+	if isWrenValue(b) && !isWrenValue(a) {
+		a, b = b, a
+	}
+	switch x := a.(type) {
+	case *ListHandle:
+		return listEqual(x, b)
+	case *MapHandle:
+		return mapEqual(x, b)
+	case *ForeignHandle:
+		value, err := x.Get()
+		if err != nil {
+			return false, err
+		}
+		if other, ok := b.(*ForeignHandle); ok {
+			if b, err = other.Get(); err != nil {
+				return false, err
+			}
+		}
+		return reflect.DeepEqual(value, b), nil
+	case *RangeHandle:
+		values, err := x.ToSlice()
+		if err != nil {
+			return false, err
+		}
+		return ValueEqual(values, b)
+	case *Handle, *FiberHandle, *ClassHandle, *FnHandle:
+		return false, &InvalidValue{Value: a}
+	}
+	if isWrenValue(b) {
+		return false, nil
+	}
+	if x, ok := normalizeScalar(a); ok {
+		y, ok := normalizeScalar(b)
+		return ok && x == y, nil
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch va.Kind() {
+	case reflect.Slice, reflect.Array:
+		if vb.Kind() != reflect.Slice && vb.Kind() != reflect.Array {
+			if _, ok := normalizeScalar(b); ok {
+				return false, nil
+			}
+			return false, &InvalidValue{Value: b}
+		}
+		if va.Len() != vb.Len() {
+			return false, nil
+		}
+		for i := 0; i < va.Len(); i++ {
+			if equal, err := ValueEqual(va.Index(i).Interface(), vb.Index(i).Interface()); !equal || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	case reflect.Map:
+		if vb.Kind() != reflect.Map {
+			if _, ok := normalizeScalar(b); ok {
+				return false, nil
+			}
+			return false, &InvalidValue{Value: b}
+		}
+		if va.Len() != vb.Len() {
+			return false, nil
+		}
+		iter := va.MapRange()
+		for iter.Next() {
+			value, ok := goMapIndex(vb, iter.Key().Interface())
+			if !ok {
+				return false, nil
+			}
+			if equal, err := ValueEqual(iter.Value().Interface(), value); !equal || err != nil {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+	return false, &InvalidValue{Value: a}
+}
+
+func isWrenValue(value interface{}) bool {
+	switch value.(type) {
+	case *Handle, *ListHandle, *MapHandle, *ForeignHandle, *FiberHandle, *ClassHandle, *FnHandle, *RangeHandle:
+		return true
+	}
+	return false
+}
+
+// normalizeScalar converts values to the Go type that Wren would give back for them (float64, string, bool or nil). If `value` isn't something Wren has a primitive for, ok is false
+func normalizeScalar(value interface{}) (normalized interface{}, ok bool) {
+	switch value := value.(type) {
+	case nil, nullValue:
+		return nil, true
+	case bool, string:
+		return value, true
+	case []byte:
+		return string(value), true
+	}
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	}
+	return nil, false
+}
+
+// goMapIndex looks up `key` in a Go map, comparing keys the way Wren would (so 1 and 1.0 are the same key)
+func goMapIndex(m reflect.Value, key interface{}) (interface{}, bool) {
+	normalized, ok := normalizeScalar(key)
+	iter := m.MapRange()
+	for iter.Next() {
+		other := iter.Key().Interface()
+		if ok {
+			if otherNormalized, ok := normalizeScalar(other); ok && otherNormalized == normalized {
+				return iter.Value().Interface(), true
+			}
+		} else if reflect.DeepEqual(key, other) {
+			return iter.Value().Interface(), true
+		}
+	}
+	return nil, false
+}
+
+func listEqual(list *ListHandle, other interface{}) (bool, error) {
+	count, err := list.Count()
+	if err != nil {
+		return false, err
+	}
+	var get func(i int) (interface{}, error)
+	switch o := other.(type) {
+	case *ListHandle:
+		otherCount, err := o.Count()
+		if err != nil || otherCount != count {
+			return false, err
+		}
+		get = o.Get
+	default:
+		v := reflect.ValueOf(other)
+		if _, isBytes := other.([]byte); isBytes || v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return false, nil
+		}
+		if v.Len() != count {
+			return false, nil
+		}
+		get = func(i int) (interface{}, error) {
+			return v.Index(i).Interface(), nil
+		}
+	}
+	for i := 0; i < count; i++ {
+		equal, err := func() (bool, error) {
+			a, err := list.Get(i)
+			if err != nil {
+				return false, err
+			}
+			defer list.VM().FreeAll(a)
+			b, err := get(i)
+			if err != nil {
+				return false, err
+			}
+			if _, ok := other.(*ListHandle); ok {
+				defer list.VM().FreeAll(b)
+			}
+			return ValueEqual(a, b)
+		}()
+		if !equal || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func mapEqual(m *MapHandle, other interface{}) (bool, error) {
+	count, err := m.Count()
+	if err != nil {
+		return false, err
+	}
+	if o, ok := other.(*MapHandle); ok {
+		return mapHandleEqual(m, o, count)
+	}
+	v := reflect.ValueOf(other)
+	if v.Kind() != reflect.Map {
+		return false, nil
+	}
+	if v.Len() != count {
+		return false, nil
+	}
+	iter := v.MapRange()
+	for iter.Next() {
+		key := iter.Key().Interface()
+		if has, err := m.Has(key); !has || err != nil {
+			return false, err
+		}
+		equal, err := func() (bool, error) {
+			value, err := m.Get(key)
+			if err != nil {
+				return false, err
+			}
+			defer m.VM().FreeAll(value)
+			return ValueEqual(value, iter.Value().Interface())
+		}()
+		if !equal || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func mapHandleEqual(m, other *MapHandle, count int) (bool, error) {
+	if otherCount, err := other.Count(); otherCount != count || err != nil {
+		return false, err
+	}
+	keys, err := m.Keys()
+	if err != nil {
+		return false, err
+	}
+	defer m.VM().FreeAll(keys...)
+	for _, key := range keys {
+		if has, err := other.Has(key); !has || err != nil {
+			return false, err
+		}
+		equal, err := func() (bool, error) {
+			a, err := m.Get(key)
+			if err != nil {
+				return false, err
+			}
+			defer m.VM().FreeAll(a)
+			b, err := other.Get(key)
+			if err != nil {
+				return false, err
+			}
+			defer m.VM().FreeAll(b)
+			return ValueEqual(a, b)
+		}()
+		if !equal || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,256 @@
+package wren
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// LockName is the file name of the lockfile that `Project.Fetch` writes next to the manifest
+const LockName = "wren.lock"
+
+// fetchClient downloads modules with "http://" and "https://" sources, so a server that stops responding can't hang `Project.Fetch`
+var fetchClient = &http.Client{Timeout: time.Minute}
+
+// Requirement is a module that a project fetches from somewhere else (see `Project.Fetch`)
+type Requirement struct {
+	// The name scripts import the module with
+	Module string
+	// Where the module is fetched from. This is either an "http://" or "https://" URL to a Wren file, or a git repository in the form "git+<repository>@<ref>#<file>" (if "#<file>" is left out, the file is "<Module>.wren")
+	Source string
+}
+
+// LockEntry records the checksum of a fetched module so later fetches can be verified
+type LockEntry struct {
+	Source string
+	// Checksum of the module's source in the form "sha256:<hex>"
+	Sum string
+}
+
+// ChecksumMismatch is returned from `Project.Fetch` if a fetched module does not match the checksum in the lockfile
+type ChecksumMismatch struct {
+	Module, Expected, Actual string
+}
+
+func (err *ChecksumMismatch) Error() string {
+	return fmt.Sprintf("Module \"%v\" has checksum %v but the lockfile expects %v", err.Module, err.Actual, err.Expected)
+}
+
+// NotFetched is sent to `ErrorFn` if a script imports a required module that has not been fetched by `Project.Fetch` yet
+type NotFetched struct {
+	Module string
+}
+
+func (err *NotFetched) Error() string {
+	return fmt.Sprintf("Required module \"%v\" has not been fetched", err.Module)
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// requirement returns the project's requirement for `module`
+func (project *Project) requirement(module string) (Requirement, bool) {
+	for _, req := range project.Requires {
+		if req.Module == module {
+			return req, true
+		}
+	}
+	return Requirement{}, false
+}
+
+func (project *Project) cacheDir() (string, error) {
+	if project.CacheDir != "" {
+		return filepath.Join(project.Dir, project.CacheDir), nil
+	}
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "wrengo", "modules"), nil
+}
+
+// cacheFile returns where a module with the checksum `sum` is stored. Modules are stored by their checksum so files can be shared between projects
+func (project *Project) cacheFile(sum string) (string, error) {
+	dir, err := project.cacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, strings.TrimPrefix(sum, "sha256:")+".wren"), nil
+}
+
+// ReadLock reads the project's lockfile. If there isn't a lockfile yet, an empty map is returned
+func (project *Project) ReadLock() (map[string]LockEntry, error) {
+	lock := make(map[string]LockEntry)
+	data, err := ioutil.ReadFile(filepath.Join(project.Dir, LockName))
+	if os.IsNotExist(err) {
+		return lock, nil
+	} else if err != nil {
+		return nil, err
+	}
+	lines := bufio.NewScanner(strings.NewReader(string(data)))
+	for line := 1; lines.Scan(); line++ {
+		fields := strings.Fields(lines.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 || !strings.HasPrefix(fields[2], "sha256:") {
+			return nil, &ManifestError{File: filepath.Join(project.Dir, LockName), Line: line, Message: "expected \"<module> <source> sha256:<hex>\""}
+		}
+		lock[fields[0]] = LockEntry{Source: fields[1], Sum: fields[2]}
+	}
+	return lock, nil
+}
+
+func (project *Project) writeLock(lock map[string]LockEntry) error {
+	modules := make([]string, 0, len(lock))
+	for module := range lock {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	var data strings.Builder
+	for _, module := range modules {
+		fmt.Fprintf(&data, "%v %v %v\n", module, lock[module].Source, lock[module].Sum)
+	}
+	return ioutil.WriteFile(filepath.Join(project.Dir, LockName), []byte(data.String()), 0644)
+}
+
+// Fetch downloads the project's required modules into the cache directory and records their checksums in the lockfile. Modules that are already cached and match the lockfile are not downloaded again. If a downloaded module does not match the checksum recorded in the lockfile, `ChecksumMismatch` is returned and nothing is written
+func (project *Project) Fetch() error {
+	lock, err := project.ReadLock()
+	if err != nil {
+		return err
+	}
+	newLock := make(map[string]LockEntry)
+	for _, req := range project.Requires {
+		entry, locked := lock[req.Module]
+		locked = locked && entry.Source == req.Source
+		if locked {
+			if file, err := project.cacheFile(entry.Sum); err == nil {
+				if data, err := ioutil.ReadFile(file); err == nil && checksum(data) == entry.Sum {
+					newLock[req.Module] = entry
+					continue
+				}
+			}
+		}
+		data, err := fetchSource(req)
+		if err != nil {
+			return err
+		}
+		sum := checksum(data)
+		if locked && sum != entry.Sum {
+			return &ChecksumMismatch{Module: req.Module, Expected: entry.Sum, Actual: sum}
+		}
+		file, err := project.cacheFile(sum)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(file, data, 0644); err != nil {
+			return err
+		}
+		newLock[req.Module] = LockEntry{Source: req.Source, Sum: sum}
+	}
+	if err := project.writeLock(newLock); err != nil {
+		return err
+	}
+	project.lockMux.Lock()
+	project.lock = newLock
+	project.lockMux.Unlock()
+	return nil
+}
+
+// loadRequired loads a required module from the cache, verifying it against the lockfile
+func (project *Project) loadRequired(module string) ([]byte, error) {
+	project.lockMux.Lock()
+	if project.lock == nil {
+		lock, err := project.ReadLock()
+		if err != nil {
+			project.lockMux.Unlock()
+			return nil, err
+		}
+		project.lock = lock
+	}
+	entry, ok := project.lock[module]
+	project.lockMux.Unlock()
+	if req, _ := project.requirement(module); !ok || entry.Source != req.Source {
+		return nil, &NotFetched{Module: module}
+	}
+	file, err := project.cacheFile(entry.Sum)
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, &NotFetched{Module: module}
+	} else if err != nil {
+		return nil, err
+	}
+	if sum := checksum(data); sum != entry.Sum {
+		return nil, &ChecksumMismatch{Module: module, Expected: entry.Sum, Actual: sum}
+	}
+	return data, nil
+}
+
+func fetchSource(req Requirement) ([]byte, error) {
+	switch {
+	case strings.HasPrefix(req.Source, "http://"), strings.HasPrefix(req.Source, "https://"):
+		resp, err := fetchClient.Get(req.Source)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetching module \"%v\" from %v: %v", req.Module, req.Source, resp.Status)
+		}
+		return ioutil.ReadAll(resp.Body)
+	case strings.HasPrefix(req.Source, "git+"):
+		return fetchGit(req)
+	default:
+		return nil, fmt.Errorf("module \"%v\" has unknown source %v", req.Module, req.Source)
+	}
+}
+
+// fetchGit clones "git+<repository>@<ref>#<file>" and reads the file. The ref has to be a branch or tag
+func fetchGit(req Requirement) ([]byte, error) {
+	repo := strings.TrimPrefix(req.Source, "git+")
+	file := req.Module + ".wren"
+	if i := strings.LastIndex(repo, "#"); i >= 0 {
+		repo, file = repo[:i], repo[i+1:]
+	}
+	ref := ""
+	if i := strings.LastIndex(repo, "@"); i > strings.LastIndex(repo, "/") {
+		repo, ref = repo[:i], repo[i+1:]
+	}
+	dir, err := ioutil.TempDir("", "wrengo-fetch")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	args := []string{"clone", "--quiet", "--depth", "1"}
+	if ref != "" {
+		args = append(args, "--branch", ref)
+	}
+	// "--" keeps a repository starting with "-" from being read as an option
+	cmd := exec.Command("git", append(args, "--", repo, dir)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("fetching module \"%v\" from %v: %v: %s", req.Module, req.Source, err, output)
+	}
+	path := filepath.Join(dir, filepath.FromSlash(file))
+	if !insideDir(dir, path) {
+		return nil, fmt.Errorf("module \"%v\" has file %v outside of its repository", req.Module, file)
+	}
+	return ioutil.ReadFile(path)
+}
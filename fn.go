@@ -0,0 +1,73 @@
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+*/
+import "C"
+import (
+	"fmt"
+	"strings"
+)
+
+// Module that holds the foreign class Go functions are wrapped in
+const fnModule = "wrengo/fn"
+
+// Wren methods can take at most 16 parameters
+const maxFnArity = 16
+
+// GoFn is a Go function that Wren can call like one of its own functions. `args` are the parameters it was called with
+type GoFn func(vm *VM, args []interface{}) (interface{}, error)
+
+// fnModuleDefinition declares the class that wraps `GoFn`s with a `call` method for every arity
+func fnModuleDefinition() *Module {
+	methods := make(MethodMap)
+	var source strings.Builder
+	source.WriteString("foreign class GoFn {\n")
+	for arity := 0; arity <= maxFnArity; arity++ {
+		signature := methodSignature("call", arity)
+		methods[signature] = func(vm *VM, parameters []interface{}) (interface{}, error) {
+			receiver, err := parameters[0].(*ForeignHandle).Get()
+			if err != nil {
+				return nil, err
+			}
+			fn, ok := receiver.(GoFn)
+			if !ok {
+				return nil, &UnexpectedValue{Value: receiver}
+			}
+			return fn(vm, parameters[1:])
+		}
+		names := make([]string, arity)
+		for i := range names {
+			names[i] = fmt.Sprintf("a%v", i)
+		}
+		fmt.Fprintf(&source, "\tforeign call(%v)\n", strings.Join(names, ", "))
+	}
+	source.WriteString("}\n")
+	module := NewModule(ClassMap{"GoFn": NewClass(nil, nil, methods)})
+	module.Source = source.String()
+	return module
+}
+
+// NewFn wraps `fn` in a foreign object that Wren can call like a function, such as `callback.call(1, 2)`. It can be passed to Wren anywhere a Wren `Fn` is expected to be called with up to 16 parameters. Unlike most functions that create values, this can be used while the VM is running, such as to return a callback from a foreign method, as long as the module "wrengo/fn" was defined already by an earlier call or by a script importing it (otherwise `ModuleNotImported` is returned). `fn` is kept for as long as Wren holds the object. The returned handle is freed once it is garbage collected in Go, so it doesn't have to be freed when it is returned from a foreign method
+func (vm *VM) NewFn(fn GoFn) (handle *ForeignHandle, err error) {
+	if vm.onThread(func() { handle, err = vm.NewFn(fn) }) {
+		return handle, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
+	}
+	if fn == nil {
+		return nil, &InvalidValue{Value: fn}
+	}
+	if err := vm.requireModule(fnModule, fnModuleDefinition); err != nil {
+		return nil, err
+	}
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	defer vm.arena.release(vm.arena.mark())
+	C.wrenGetVariable(vm.vm, vm.arena.cString(fnModule), vm.arena.cString("GoFn"), C.int(base))
+	vm.newForeign(base+1, base, foreignInstance{value: fn})
+	return &ForeignHandle{handle: vm.newHandle(C.wrenGetSlotHandle(vm.vm, C.int(base+1)), true)}, nil
+}
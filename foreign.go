@@ -6,8 +6,13 @@ package wren
 #include "wren.h"
 */
 import "C"
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
 
-// ForeignMethodFn is a function that wren can import or call. The value of parameters[0] will be the foreign object itself while anything after that are the parameters from the wren function. if it returns an error, then it will call `vm.Abort`.
+// ForeignMethodFn is a function that wren can import or call. The value of parameters[0] will be the foreign object itself while anything after that are the parameters from the wren function. if it returns an error, then it will call `vm.Abort`. If it returns nil, Wren gets the receiver back as the return value (return `Null` to give Wren `null` instead).
 // Handles that originated from `parameters` are automatically freed by WrenGo. If you want to keep the handle, you need to call copy on it.
 type ForeignMethodFn func(vm *VM, parameters []interface{}) (interface{}, error)
 
@@ -23,6 +28,10 @@ type ModuleMap map[string]*Module
 // Module contains a `ClassMap` which is a map containing foreign classes (or classes where objects are made in Go and not Wren) organized by class name
 type Module struct {
 	ClassMap ClassMap
+	// Wren source code for this module. If it is set, Wren will use this when the module is imported instead of calling `LoadModuleFn`
+	Source string
+	// If true, the declarations from `WrenSource` are put before `Source` when the module is imported, so the classes in `ClassMap` don't have to be declared by hand. Modules that are interpreted directly (such as with `InterpretString`) don't get them
+	Declare bool
 }
 
 // ClassMap is a map containing all foreign classes (or classes where objects are made in Go and not Wren) organized by class name
@@ -36,6 +45,8 @@ type ForeignClass struct {
 	Finalizer ForeignFinalizer
 	// A map containing `ForeignMethodFn`s organized by function signatures. see MethodMap for mor information on signatures syntax.
 	MethodMap MethodMap
+	// Signatures of the constructors that `Module.WrenSource` declares, such as "new(_,_)". If it is empty, "new()" is declared for classes with an `Initializer`
+	Constructors []string
 }
 
 // MethodMap is a map containing `ForeignMethodFn`s organized by signatures.
@@ -56,7 +67,9 @@ type ForeignClass struct {
 //
 // - A static function called "foo" with 3 parameters will look like "static foo(_,_,_)"
 //
-// - A function that isn't static called "bar" with no parameters will look like "static bar()"
+// - A function that isn't static called "bar" with no parameters will look like "bar()"
+//
+// Getters, setters, subscripts, and operators are written differently (such as "name", "name=(_)", "[_]", and "+(_)"). `Getter`, `Setter`, `Subscript`, `SubscriptSetter`, `Operator`, and `Static` build these signatures
 type MethodMap map[string]ForeignMethodFn
 
 // Clone creates a copy clone of all modules and classes this `ModuleMap` references
@@ -72,7 +85,17 @@ func (modules ModuleMap) Clone() ModuleMap {
 func (modules ModuleMap) Merge(source ModuleMap) ModuleMap {
 	for name, module := range source {
 		if module != nil {
+			if modules[name] == nil {
+				modules[name] = module.Clone()
+				continue
+			}
 			modules[name].ClassMap.Merge(module.ClassMap)
+			if module.Source != "" {
+				modules[name].Source = module.Source
+			}
+			if module.Declare {
+				modules[name].Declare = true
+			}
 		}
 	}
 	return modules
@@ -80,7 +103,10 @@ func (modules ModuleMap) Merge(source ModuleMap) ModuleMap {
 
 // Clone creates a copy of all classes this `Module` references
 func (module *Module) Clone() *Module {
-	return NewModule(module.ClassMap.Clone())
+	newModule := NewModule(module.ClassMap)
+	newModule.Source = module.Source
+	newModule.Declare = module.Declare
+	return newModule
 }
 
 // NewModule creates a new `Module` from the given `ClassMap`
@@ -101,6 +127,10 @@ func (classes ClassMap) Clone() ClassMap {
 func (classes ClassMap) Merge(source ClassMap) ClassMap {
 	for name, class := range source {
 		if class != nil {
+			if classes[name] == nil {
+				classes[name] = class.Clone()
+				continue
+			}
 			classes[name].MethodMap.Merge(class.MethodMap)
 		}
 	}
@@ -117,7 +147,9 @@ func NewClass(initializer ForeignInitializer, finalizer ForeignFinalizer, method
 
 // Clone creates a copy of the current `ForeignClass`
 func (class *ForeignClass) Clone() *ForeignClass {
-	return NewClass(class.Initializer, class.Finalizer, class.MethodMap.Clone())
+	newClass := NewClass(class.Initializer, class.Finalizer, class.MethodMap.Clone())
+	newClass.Constructors = append([]string(nil), class.Constructors...)
+	return newClass
 }
 
 // Clone creates a copy of the current `MethodMap`
@@ -138,3 +170,81 @@ func (methods MethodMap) Merge(source MethodMap) MethodMap {
 	}
 	return methods
 }
+
+// WrenSource returns Wren declarations for the classes in the module, in order of their names. Classes with an `Initializer` or `Finalizer` are declared as foreign classes with their `Constructors`, and every signature in their `MethodMap` is declared as a foreign method (signatures that `Validate` rejects are left out)
+func (module *Module) WrenSource() string {
+	names := make([]string, 0, len(module.ClassMap))
+	for name, class := range module.ClassMap {
+		if class != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var source strings.Builder
+	for _, name := range names {
+		class := module.ClassMap[name]
+		if class.Initializer != nil || class.Finalizer != nil {
+			source.WriteString("foreign ")
+		}
+		fmt.Fprintf(&source, "class %v {\n", name)
+		if class.Initializer != nil {
+			constructors := class.Constructors
+			if len(constructors) == 0 {
+				constructors = []string{"new()"}
+			}
+			for _, constructor := range constructors {
+				if sig, err := ParseSignature(constructor); err == nil && sig.Kind == SignatureMethod && !sig.Static {
+					fmt.Fprintf(&source, "\tconstruct %v {}\n", declareSignature(sig))
+				}
+			}
+		}
+		signatures := make([]string, 0, len(class.MethodMap))
+		for signature := range class.MethodMap {
+			signatures = append(signatures, signature)
+		}
+		sort.Strings(signatures)
+		for _, signature := range signatures {
+			if sig, err := ParseSignature(signature); err == nil {
+				fmt.Fprintf(&source, "\tforeign %v\n", declareSignature(sig))
+			}
+		}
+		source.WriteString("}\n")
+	}
+	return source.String()
+}
+
+// source returns what Wren interprets when the module is imported
+func (module *Module) source() string {
+	if module.Declare {
+		return module.WrenSource() + module.Source
+	}
+	return module.Source
+}
+
+// declareSignature writes a signature the way it is declared in a class, with parameters named "a0", "a1", and so on
+func declareSignature(sig *Signature) string {
+	params := make([]string, sig.Arity)
+	for i := range params {
+		params[i] = fmt.Sprintf("a%v", i)
+	}
+	list := strings.Join(params, ", ")
+	var s string
+	switch sig.Kind {
+	case SignatureMethod:
+		s = sig.Name + "(" + list + ")"
+	case SignatureGetter, SignaturePrefixOperator:
+		s = sig.Name
+	case SignatureSetter:
+		s = sig.Name + "=(value)"
+	case SignatureSubscript:
+		s = "[" + list + "]"
+	case SignatureSubscriptSetter:
+		s = "[" + list + "]=(value)"
+	case SignatureInfixOperator:
+		s = sig.Name + "(other)"
+	}
+	if sig.Static {
+		return "static " + s
+	}
+	return s
+}
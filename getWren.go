@@ -13,6 +13,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"strings"
 )
 
 func main() {
@@ -31,6 +32,8 @@ func main() {
 	cloneWren()
 	println("Generating amalgamation")
 	makeAmalgamation()
+	println("Patching amalgamation")
+	patchAmalgamation()
 	println("Copying header files")
 	copyHeader()
 	println("Success!")
@@ -69,6 +72,223 @@ func makeAmalgamation() {
 	}
 }
 
+// wrenPatches are the changes WrenGo makes to Wren, as pairs of the code to
+// find in the amalgamation and the code to replace it with. They add a
+// checkpoint to the interpreter loop so scripts can be stopped from Go, and
+// functions that read what Wren's API doesn't expose, such as the call stack
+// for `VM.CallStack`
+var wrenPatches = [][2]string{
+	{
+		`// Aborts the current fiber with an appropriate method not found error for a
+// method with [symbol] on [classObj].
+`,
+		`// WrenGo: Called by the interpreter on every backward jump and method call, so
+// the embedder can stop a script even if it never calls a foreign method.
+// Returns NULL to keep running or an error message, allocated with malloc(),
+// to stop the script with.
+extern char* wrengoCheckpoint(WrenVM* vm);
+
+// WrenGo: Aborts the current fiber and every fiber that called it with
+// [message], then frees [message]. Unlike [runtimeError], fibers run with
+// "try" don't catch the error, so a script that was stopped can't keep going.
+static void wrengoAbort(WrenVM* vm, char* message)
+{
+  vm->fiber->error = wrenNewString(vm, message);
+  free(message);
+
+  Value error = vm->fiber->error;
+  ObjFiber* current = vm->fiber;
+  while (current != NULL)
+  {
+    current->error = error;
+    ObjFiber* caller = current->caller;
+    current->caller = NULL;
+    current = caller;
+  }
+
+  wrenDebugPrintStackTrace(vm);
+  vm->fiber = NULL;
+  vm->apiStack = NULL;
+}
+
+// Aborts the current fiber with an appropriate method not found error for a
+// method with [symbol] on [classObj].
+`,
+	},
+	{
+		`        if (vm->fiber == NULL) return WREN_RESULT_RUNTIME_ERROR;               \
+        fiber = vm->fiber;                                                     \
+        LOAD_FRAME();                                                          \
+        DISPATCH();                                                            \
+      } while (false)
+`,
+		`        if (vm->fiber == NULL) return WREN_RESULT_RUNTIME_ERROR;               \
+        fiber = vm->fiber;                                                     \
+        LOAD_FRAME();                                                          \
+        DISPATCH();                                                            \
+      } while (false)
+
+  // WrenGo: Lets the embedder stop the script. Every loop and recursion passes
+  // through it, since it is used on backward jumps and method calls.
+  #define CHECKPOINT()                                                         \
+      do                                                                       \
+      {                                                                        \
+        STORE_FRAME();                                                         \
+        char* checkpointError = wrengoCheckpoint(vm);                          \
+        if (checkpointError != NULL)                                           \
+        {                                                                      \
+          wrengoAbort(vm, checkpointError);                                    \
+          return WREN_RESULT_RUNTIME_ERROR;                                    \
+        }                                                                      \
+      } while (false)
+`,
+	},
+	{
+		`    completeCall:
+      // If the class's method table doesn't include the symbol, bail.
+`,
+		`    completeCall:
+      CHECKPOINT();
+
+      // If the class's method table doesn't include the symbol, bail.
+`,
+	},
+	{
+		`      // Jump back to the top of the loop.
+      uint16_t offset = READ_SHORT();
+      ip -= offset;
+      DISPATCH();
+`,
+		`      // Jump back to the top of the loop.
+      uint16_t offset = READ_SHORT();
+      CHECKPOINT();
+      ip -= offset;
+      DISPATCH();
+`,
+	},
+	{
+		`static void dumpObject(Obj* obj)
+{
+`,
+		`// WrenGo: Returns how many frames the running fiber has, so the embedder can
+// read its call stack.
+int wrengoFrameCount(WrenVM* vm)
+{
+  return vm->fiber == NULL ? 0 : vm->fiber->numFrames;
+}
+
+// WrenGo: Reads the frame [index] frames below the top of the running fiber's
+// stack like [wrenDebugPrintStackTrace] does. Frames of the core module and of
+// calls from the API have no module and return false. The line of the top
+// frame is 0 since its IP is only stored when it calls another method.
+bool wrengoFrame(WrenVM* vm, int index, const char** module, int* line,
+                 const char** name)
+{
+  ObjFiber* fiber = vm->fiber;
+  CallFrame* frame = &fiber->frames[fiber->numFrames - 1 - index];
+  ObjFn* fn = frame->closure->fn;
+  if (fn->module == NULL || fn->module->name == NULL) return false;
+
+  *module = fn->module->name->value;
+  *name = fn->debug->name;
+  *line = 0;
+
+  // -1 because IP has advanced past the instruction that made the call.
+  long i = (long)(frame->ip - fn->code.data) - 1;
+  if (index > 0 && i >= 0 && i < fn->debug->sourceLines.count)
+  {
+    *line = fn->debug->sourceLines.data[i];
+  }
+  return true;
+}
+
+static void dumpObject(Obj* obj)
+{
+`,
+	},
+	{
+		`void wrenReleaseHandle(WrenVM* vm, WrenHandle* handle)
+{
+`,
+		`// WrenGo: Returns whether the last interpretation or call was suspended with
+// Fiber.suspend(), which leaves the VM without a fiber until it is resumed.
+bool wrengoSuspended(WrenVM* vm)
+{
+  return vm->fiber == NULL;
+}
+
+// WrenGo: Makes a handle to the value the running fiber aborted with, or
+// returns NULL if there is no fiber. This only reads the fiber's error so it is
+// safe to call while a runtime error is being reported.
+WrenHandle* wrengoFiberError(WrenVM* vm)
+{
+  if (vm->fiber == NULL) return NULL;
+  return wrenMakeHandle(vm, vm->fiber->error);
+}
+
+void wrenReleaseHandle(WrenVM* vm, WrenHandle* handle)
+{
+`,
+	},
+	{
+		`WrenInterpretResult wrenInterpret(WrenVM* vm, const char* module,
+                                  const char* source)
+{
+`,
+		`// WrenGo: Returns the number the range in [handle] starts at.
+double wrengoRangeFrom(WrenHandle* handle)
+{
+  return AS_RANGE(handle->value)->from;
+}
+
+// WrenGo: Returns the number the range in [handle] ends at.
+double wrengoRangeTo(WrenHandle* handle)
+{
+  return AS_RANGE(handle->value)->to;
+}
+
+// WrenGo: Returns whether the end of the range in [handle] is part of it.
+bool wrengoRangeIsInclusive(WrenHandle* handle)
+{
+  return AS_RANGE(handle->value)->isInclusive;
+}
+
+WrenInterpretResult wrenInterpret(WrenVM* vm, const char* module,
+                                  const char* source)
+{
+`,
+	},
+	{
+		`int wrenGetSlotCount(WrenVM* vm)
+{
+`,
+		`// WrenGo: Returns whether [handle] holds a foreign class.
+bool wrengoClassIsForeign(WrenHandle* handle)
+{
+  return IS_CLASS(handle->value) && AS_CLASS(handle->value)->numFields == -1;
+}
+
+int wrenGetSlotCount(WrenVM* vm)
+{
+`,
+	},
+}
+
+func patchAmalgamation() {
+	data, err := ioutil.ReadFile("wren.c")
+	if err != nil {
+		panic(err.Error())
+	}
+	source := string(data)
+	for _, patch := range wrenPatches {
+		if strings.Count(source, patch[0]) != 1 {
+			panic("Could not find where to patch wren.c:\n" + patch[0])
+		}
+		source = strings.Replace(source, patch[0], patch[1], 1)
+	}
+	ioutil.WriteFile("wren.c", []byte(source), os.ModePerm)
+}
+
 func copyHeader() {
 	data, err := ioutil.ReadFile("wren-c/src/include/wren.h")
 	if err != nil {
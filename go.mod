@@ -1,3 +1,3 @@
 module github.com/crazyinfin8/WrenGo
 
-go 1.13
+go 1.18
@@ -0,0 +1,634 @@
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include <stdint.h>
+#include "wren.h"
+
+// Wren's API reports fibers, classes, and functions as WREN_TYPE_UNKNOWN. To
+// tell them apart, this mirrors how Wren 0.4 stores values (built with NaN
+// tagging, which is the default): a handle starts with its value and every
+// object starts with its type. This must be kept in sync with wren.c when it
+// is updated.
+enum {
+	WRENGO_OBJ_CLASS,
+	WRENGO_OBJ_CLOSURE,
+	WRENGO_OBJ_FIBER,
+	WRENGO_OBJ_FN,
+	WRENGO_OBJ_FOREIGN,
+	WRENGO_OBJ_INSTANCE,
+	WRENGO_OBJ_LIST,
+	WRENGO_OBJ_MAP,
+	WRENGO_OBJ_MODULE,
+	WRENGO_OBJ_RANGE,
+	WRENGO_OBJ_STRING,
+	WRENGO_OBJ_UPVALUE
+};
+
+#define WRENGO_SIGN_BIT ((uint64_t)1 << 63)
+#define WRENGO_QNAN ((uint64_t)0x7ffc000000000000)
+
+// Returns the type of object the handle holds or -1 if it isn't an object
+static int wrengoObjectType(WrenHandle* handle) {
+	uint64_t value = *(uint64_t*)handle;
+	if ((value & (WRENGO_QNAN | WRENGO_SIGN_BIT)) != (WRENGO_QNAN | WRENGO_SIGN_BIT)) {
+		return -1;
+	}
+	return *(int*)(uintptr_t)(value & ~(WRENGO_QNAN | WRENGO_SIGN_BIT));
+}
+
+static uint64_t wrengoHandleValue(WrenHandle* handle) {
+	return *(uint64_t*)handle;
+}
+
+// Not part of Wren's API but exported by wren.c
+extern WrenHandle* wrenMakeHandle(WrenVM* vm, uint64_t value);
+
+// Added to wren.c by the patches in getWren.go, so they are compiled against
+// Wren's own structures instead of mirroring them here
+extern int wrengoFrameCount(WrenVM* vm);
+extern bool wrengoFrame(WrenVM* vm, int index, const char** module, int* line, const char** name);
+extern bool wrengoSuspended(WrenVM* vm);
+extern WrenHandle* wrengoFiberError(WrenVM* vm);
+extern double wrengoRangeFrom(WrenHandle* handle);
+extern double wrengoRangeTo(WrenHandle* handle);
+extern bool wrengoRangeIsInclusive(WrenHandle* handle);
+extern bool wrengoClassIsForeign(WrenHandle* handle);
+*/
+import "C"
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// objectHandle wraps a handle to a value that Wren doesn't have a slot type for in the handle type that matches it
+func (vm *VM) objectHandle(handle *C.WrenHandle) interface{} {
+	return wrapObject(vm.createHandle(handle))
+}
+
+// wrapObject wraps `h` in the handle type that matches the object it holds
+func wrapObject(h *Handle) interface{} {
+	switch C.wrengoObjectType(h.handle) {
+	case C.WRENGO_OBJ_FIBER:
+		return &FiberHandle{handle: h}
+	case C.WRENGO_OBJ_CLOSURE:
+		return &FnHandle{handle: h}
+	case C.WRENGO_OBJ_LIST:
+		return &ListHandle{handle: h}
+	case C.WRENGO_OBJ_MAP:
+		return &MapHandle{handle: h}
+	case C.WRENGO_OBJ_FOREIGN:
+		return &ForeignHandle{handle: h}
+	case C.WRENGO_OBJ_RANGE:
+		return &RangeHandle{handle: h}
+	}
+	return h
+}
+
+// Wren's NaN tagged encoding of values that aren't numbers or objects
+const (
+	wrenQNAN  = 0x7ffc000000000000
+	wrenNull  = wrenQNAN | 1
+	wrenFalse = wrenQNAN | 2
+	wrenTrue  = wrenQNAN | 3
+)
+
+// abortValue gets the value the running fiber aborted with while Wren is reporting a runtime error. Slots can't be used then (they would replace the fiber), so the value is read from a handle instead. Strings are returned as `message`, which Wren already passed to `ErrorFn`. Objects are returned in handles that are released once they are garbage collected, since most errors are never looked at (`attachReported` converts lists and maps once slots can be used)
+func (vm *VM) abortValue(message string) interface{} {
+	handle := C.wrengoFiberError(vm.vm)
+	if handle == nil {
+		return message
+	}
+	bits := uint64(C.wrengoHandleValue(handle))
+	var value interface{}
+	switch {
+	case bits&wrenQNAN != wrenQNAN:
+		value = math.Float64frombits(bits)
+	case bits == wrenNull:
+		value = nil
+	case bits == wrenFalse:
+		value = false
+	case bits == wrenTrue:
+		value = true
+	case C.wrengoObjectType(handle) == C.WRENGO_OBJ_STRING:
+		value = message
+	default:
+		return wrapObject(vm.newHandle(handle, true))
+	}
+	C.wrenReleaseHandle(vm.vm, handle)
+	return value
+}
+
+// CallStack returns the stack of the fiber that is running, with the innermost frame first, such as to tell what called a foreign method. The foreign method itself isn't part of the stack. Wren only records where a method is when it calls another Wren method, so the line of the innermost frame (the one that called the foreign method) is 0. If the VM isn't running, nil is returned
+func (vm *VM) CallStack() (frames []StackFrame) {
+	if vm.onThread(func() { frames = vm.CallStack() }) {
+		return frames
+	}
+	if vm.vm == nil || !vm.running || vm.suspended() {
+		return nil
+	}
+	stack := []StackFrame{}
+	for i, count := 0, int(C.wrengoFrameCount(vm.vm)); i < count; i++ {
+		var (
+			module, name *C.char
+			line         C.int
+		)
+		if C.wrengoFrame(vm.vm, C.int(i), &module, &line, &name) {
+			stack = append(stack, StackFrame{Module: C.GoString(module), Line: int(line), Function: C.GoString(name)})
+		}
+	}
+	return stack
+}
+
+// WrenType is the type of a Wren value. Unlike the types in Wren's API, fibers, classes, functions, and ranges have their own types
+type WrenType int
+
+const (
+	TypeUnknown WrenType = iota
+	TypeNull
+	TypeBool
+	TypeNum
+	TypeString
+	TypeList
+	TypeMap
+	TypeRange
+	TypeForeign
+	TypeFiber
+	TypeClass
+	TypeFn
+	// An instance of a class written in Wren
+	TypeInstance
+)
+
+var wrenTypeNames = [...]string{"unknown", "null", "bool", "num", "string", "list", "map", "range", "foreign", "fiber", "class", "fn", "instance"}
+
+func (t WrenType) String() string {
+	if t < 0 || int(t) >= len(wrenTypeNames) {
+		return wrenTypeNames[TypeUnknown]
+	}
+	return wrenTypeNames[t]
+}
+
+// Type returns the type of the value the handle holds. It only reads the handle so it can be used while the VM is running. `TypeUnknown` is returned for nil handles and objects that scripts can't normally get to, such as modules
+func (h *Handle) Type() (t WrenType) {
+	if h.onThread(func() { t = h.Type() }) {
+		return t
+	}
+	if !h.live() {
+		return TypeUnknown
+	}
+	bits := uint64(C.wrengoHandleValue(h.handle))
+	switch {
+	case bits&wrenQNAN != wrenQNAN:
+		return TypeNum
+	case bits == wrenNull:
+		return TypeNull
+	case bits == wrenFalse, bits == wrenTrue:
+		return TypeBool
+	}
+	switch C.wrengoObjectType(h.handle) {
+	case C.WRENGO_OBJ_STRING:
+		return TypeString
+	case C.WRENGO_OBJ_LIST:
+		return TypeList
+	case C.WRENGO_OBJ_MAP:
+		return TypeMap
+	case C.WRENGO_OBJ_RANGE:
+		return TypeRange
+	case C.WRENGO_OBJ_FOREIGN:
+		return TypeForeign
+	case C.WRENGO_OBJ_FIBER:
+		return TypeFiber
+	case C.WRENGO_OBJ_CLASS:
+		return TypeClass
+	case C.WRENGO_OBJ_CLOSURE:
+		return TypeFn
+	case C.WRENGO_OBJ_INSTANCE:
+		return TypeInstance
+	}
+	return TypeUnknown
+}
+
+// ClassName returns the name of the class of the value the handle holds, like `value.type.name` in Wren. This is mostly useful to tell apart values of `TypeInstance` and `TypeForeign`. This cannot be used while the VM is running
+func (h *Handle) ClassName() (s string, err error) {
+	if h.onThread(func() { s, err = h.ClassName() }) {
+		return s, err
+	}
+	if !h.live() {
+		return "", &NilHandleError{}
+	}
+	class, err := h.vm.callMethod(h, "type")
+	if err != nil {
+		return "", err
+	}
+	handle, ok := class.(*Handle)
+	if !ok {
+		h.vm.FreeAll(class)
+		return "", &UnexpectedValue{Value: class}
+	}
+	defer handle.Free()
+	return (&ClassHandle{handle: handle}).Name()
+}
+
+// isForeignClass reports whether the value in `slot` is a foreign class
+func (vm *VM) isForeignClass(slot int) bool {
+	handle := C.wrenGetSlotHandle(vm.vm, C.int(slot))
+	defer C.wrenReleaseHandle(vm.vm, handle)
+	return bool(C.wrengoClassIsForeign(handle))
+}
+
+// suspended reports whether the last interpretation or call ended with the fiber suspended by `Fiber.suspend()`
+func (vm *VM) suspended() bool {
+	return bool(C.wrengoSuspended(vm.vm))
+}
+
+// Remove removes the element at `index` from the Wren list and returns it by calling `removeAt(_)`. Negative indices count back from the end of the list. Like any call into Wren, this cannot be used while the VM is running
+func (h *ListHandle) Remove(index int) (value interface{}, err error) {
+	if h.handle.onThread(func() { value, err = h.Remove(index) }) {
+		return value, err
+	}
+	handle := h.Handle()
+	if !handle.live() {
+		return nil, &NilHandleError{}
+	}
+	count, err := h.Count()
+	if err != nil {
+		return nil, err
+	}
+	i, ok := listIndex(index, count, false)
+	if !ok {
+		return nil, &OutOfBounds{List: h, Index: index}
+	}
+	return h.VM().callMethod(handle, "removeAt(_)", i)
+}
+
+// Clear removes every element from the Wren list by calling `clear()`. Like any call into Wren, this cannot be used while the VM is running
+func (h *ListHandle) Clear() (err error) {
+	if h.handle.onThread(func() { err = h.Clear() }) {
+		return err
+	}
+	handle := h.Handle()
+	if !handle.live() {
+		return &NilHandleError{}
+	}
+	_, err = h.VM().callMethod(handle, "clear()")
+	return err
+}
+
+// Equals compares the object with `other` using Wren's `==`, so classes that override it decide what is equal. `other` can be any value that can be passed to Wren. Like any call into Wren, this cannot be used while the VM is running
+func (h *Handle) Equals(other interface{}) (ok bool, err error) {
+	if h.onThread(func() { ok, err = h.Equals(other) }) {
+		return ok, err
+	}
+	if !h.live() {
+		return false, &NilHandleError{}
+	}
+	value, err := h.vm.callMethod(h, "==(_)", other)
+	if err != nil {
+		return false, err
+	}
+	equal, ok := value.(bool)
+	if !ok {
+		h.vm.FreeAll(value)
+		return false, &UnexpectedValue{Value: value}
+	}
+	return equal, nil
+}
+
+// Same reports whether both handles hold the very same Wren object (or the same number, boolean, or null), like `Object.same` in Wren. It doesn't call into Wren so it can be used while the VM is running
+func (h *Handle) Same(other *Handle) (ok bool) {
+	if h.onThread(func() { ok = h.Same(other) }) {
+		return ok
+	}
+	if other == nil || !h.live() || !other.live() || h.vm != other.vm {
+		return false
+	}
+	return C.wrengoHandleValue(h.handle) == C.wrengoHandleValue(other.handle)
+}
+
+// methodSignature builds the signature of a method called `name` with `arity` parameters, such as "new(_,_)"
+func methodSignature(name string, arity int) string {
+	return name + "(" + strings.TrimSuffix(strings.Repeat("_,", arity), ",") + ")"
+}
+
+// callMethod calls `signature` on `receiver` once. Like any call into Wren, this cannot be used while the VM is running, and it returns `ReentrantCallError` even if `Config.QueueReentrantCalls` is set since its result is needed right away
+func (vm *VM) callMethod(receiver *Handle, signature string, parameters ...interface{}) (value interface{}, err error) {
+	if vm.onThread(func() { value, err = vm.callMethod(receiver, signature, parameters...) }) {
+		return value, err
+	}
+	if vm.running {
+		return nil, &ReentrantCallError{}
+	}
+	fn, err := receiver.Func(signature)
+	if err != nil {
+		return nil, err
+	}
+	defer fn.Free()
+	return fn.Call(parameters...)
+}
+
+// FiberHandle is a handle to a fiber in Wren. Its methods call into Wren so they cannot be used while the VM is running
+type FiberHandle struct {
+	handle *Handle
+}
+
+// Free releases the handle tied to it. The handle should be freed when no longer in use. The handle should not be used after it has been freed
+func (h *FiberHandle) Free() {
+	h.handle.Free()
+}
+
+// VM returns the vm that this handle belongs to
+func (h *FiberHandle) VM() *VM {
+	return h.handle.vm
+}
+
+// Handle returns the generic handle it this `FiberHandle` is tied to
+func (h *FiberHandle) Handle() *Handle {
+	return h.handle
+}
+
+// Func creates a callable handle from the Wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
+func (h *FiberHandle) Func(signature string) (*CallHandle, error) {
+	return h.handle.Func(signature)
+}
+
+// Copy creates a new `FiberHandle` to the same fiber
+func (h *FiberHandle) Copy() (*FiberHandle, error) {
+	handle, err := h.handle.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &FiberHandle{handle: handle}, nil
+}
+
+// resume calls `method` on the fiber with at most one value to send to it
+func (h *FiberHandle) resume(method string, value []interface{}) (interface{}, error) {
+	switch len(value) {
+	case 0:
+		return h.VM().callMethod(h.handle, method+"()")
+	case 1:
+		return h.VM().callMethod(h.handle, method+"(_)", value[0])
+	}
+	return nil, &ArityMismatch{Expected: 1, Got: len(value)}
+}
+
+// Call runs the fiber until it finishes or yields and returns the value it returned or yielded. If `value` is given, it is sent to the fiber as the result of the `Fiber.yield()` it is paused at. If the fiber aborts, its error is returned
+func (h *FiberHandle) Call(value ...interface{}) (interface{}, error) {
+	return h.resume("call", value)
+}
+
+// Try is like `Call` but if the fiber aborts, the error it aborted with is returned as the value instead
+func (h *FiberHandle) Try(value ...interface{}) (interface{}, error) {
+	return h.resume("try", value)
+}
+
+// IsDone returns true if the fiber has finished running or aborted
+func (h *FiberHandle) IsDone() (bool, error) {
+	value, err := h.VM().callMethod(h.handle, "isDone")
+	if err != nil {
+		return false, err
+	}
+	done, ok := value.(bool)
+	if !ok {
+		h.VM().FreeAll(value)
+		return false, &UnexpectedValue{Value: value}
+	}
+	return done, nil
+}
+
+// Error returns the value the fiber aborted with, or nil if it hasn't aborted
+func (h *FiberHandle) Error() (interface{}, error) {
+	return h.VM().callMethod(h.handle, "error")
+}
+
+// ClassHandle is a handle to a class in Wren. Static methods are called with the class as their receiver, so they can be called with `Func`. Classes are passed to Go as generic `Handle`s like other objects, so class handles are made with `VM.GetClass`
+type ClassHandle struct {
+	handle *Handle
+}
+
+// Free releases the handle tied to it. The handle should be freed when no longer in use. The handle should not be used after it has been freed
+func (h *ClassHandle) Free() {
+	h.handle.Free()
+}
+
+// VM returns the vm that this handle belongs to
+func (h *ClassHandle) VM() *VM {
+	return h.handle.vm
+}
+
+// Handle returns the generic handle it this `ClassHandle` is tied to
+func (h *ClassHandle) Handle() *Handle {
+	return h.handle
+}
+
+// Func creates a callable handle from the Wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
+func (h *ClassHandle) Func(signature string) (*CallHandle, error) {
+	return h.handle.Func(signature)
+}
+
+// Copy creates a new `ClassHandle` to the same class
+func (h *ClassHandle) Copy() (*ClassHandle, error) {
+	handle, err := h.handle.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &ClassHandle{handle: handle}, nil
+}
+
+// New creates an instance of the class by calling its constructor named "new" that takes as many parameters as are passed in. This cannot be used while the VM is running
+func (h *ClassHandle) New(parameters ...interface{}) (interface{}, error) {
+	return h.VM().callMethod(h.handle, methodSignature("new", len(parameters)), parameters...)
+}
+
+// Name returns the name of the class. This cannot be used while the VM is running
+func (h *ClassHandle) Name() (string, error) {
+	value, err := h.VM().callMethod(h.handle, "name")
+	if err != nil {
+		return "", err
+	}
+	name, ok := value.(string)
+	if !ok {
+		h.VM().FreeAll(value)
+		return "", &UnexpectedValue{Value: value}
+	}
+	return name, nil
+}
+
+// GetClass gets the class `name` from `module` as a `ClassHandle`. If the variable isn't a class, `UnexpectedValue` is returned
+func (vm *VM) GetClass(module, name string) (class *ClassHandle, err error) {
+	if vm.onThread(func() { class, err = vm.GetClass(module, name) }) {
+		return class, err
+	}
+	value, err := vm.GetVariable(module, name)
+	if err != nil {
+		return nil, err
+	}
+	handle, ok := value.(*Handle)
+	if !ok || handle.Type() != TypeClass {
+		vm.FreeAll(value)
+		return nil, &UnexpectedValue{Value: value}
+	}
+	return &ClassHandle{handle: handle}, nil
+}
+
+// FnHandle is a handle to a function object in Wren, such as one made with `Fn.new`. Like other parameters, an `FnHandle` passed to a foreign method is freed when the method returns, so it should be copied to call it later
+type FnHandle struct {
+	handle *Handle
+}
+
+// Free releases the handle tied to it. The handle should be freed when no longer in use. The handle should not be used after it has been freed
+func (h *FnHandle) Free() {
+	h.handle.Free()
+}
+
+// VM returns the vm that this handle belongs to
+func (h *FnHandle) VM() *VM {
+	return h.handle.vm
+}
+
+// Handle returns the generic handle it this `FnHandle` is tied to
+func (h *FnHandle) Handle() *Handle {
+	return h.handle
+}
+
+// Func creates a callable handle from the Wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
+func (h *FnHandle) Func(signature string) (*CallHandle, error) {
+	return h.handle.Func(signature)
+}
+
+// Copy creates a new `FnHandle` to the same function
+func (h *FnHandle) Copy() (*FnHandle, error) {
+	handle, err := h.handle.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &FnHandle{handle: handle}, nil
+}
+
+// Call calls the function with `parameters`, using the `call` method that takes as many parameters as are passed in. Wren aborts if the function takes more parameters than it is given. This cannot be used while the VM is running
+func (h *FnHandle) Call(parameters ...interface{}) (interface{}, error) {
+	return h.VM().callMethod(h.handle, methodSignature("call", len(parameters)), parameters...)
+}
+
+// RangeHandle is a handle to a range in Wren, such as `1..10`. Ranges can't be changed, so unlike most handles its methods only read the range and can be used while the VM is running
+type RangeHandle struct {
+	handle *Handle
+}
+
+// Free releases the handle tied to it. The handle should be freed when no longer in use. The handle should not be used after it has been freed
+func (h *RangeHandle) Free() {
+	h.handle.Free()
+}
+
+// VM returns the vm that this handle belongs to
+func (h *RangeHandle) VM() *VM {
+	return h.handle.vm
+}
+
+// Handle returns the generic handle it this `RangeHandle` is tied to
+func (h *RangeHandle) Handle() *Handle {
+	return h.handle
+}
+
+// Func creates a callable handle from the Wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
+func (h *RangeHandle) Func(signature string) (*CallHandle, error) {
+	return h.handle.Func(signature)
+}
+
+// Copy creates a new `RangeHandle` to the same range
+func (h *RangeHandle) Copy() (*RangeHandle, error) {
+	handle, err := h.handle.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &RangeHandle{handle: handle}, nil
+}
+
+// get reads the bounds of the range and whether its end is part of it
+func (h *RangeHandle) get() (from, to float64, inclusive bool, err error) {
+	if !h.handle.live() {
+		return 0, 0, false, &NilHandleError{}
+	}
+	handle := h.handle.handle
+	return float64(C.wrengoRangeFrom(handle)), float64(C.wrengoRangeTo(handle)), bool(C.wrengoRangeIsInclusive(handle)), nil
+}
+
+// From returns the number the range starts at
+func (h *RangeHandle) From() (float64, error) {
+	from, _, _, err := h.get()
+	return from, err
+}
+
+// To returns the number the range ends at. It may be less than `From`
+func (h *RangeHandle) To() (float64, error) {
+	_, to, _, err := h.get()
+	return to, err
+}
+
+// IsInclusive returns true if `To` is part of the range (`1..10` rather than `1...10`)
+func (h *RangeHandle) IsInclusive() (bool, error) {
+	_, _, inclusive, err := h.get()
+	return inclusive, err
+}
+
+// UnboundedRange is returned from `RangeHandle.Iterator` and `RangeHandle.ToSlice` if the range starts or ends at infinity or NaN, or at a number too large to count by one from, since stepping through it would never end
+type UnboundedRange struct {
+	From, To float64
+}
+
+func (err *UnboundedRange) Error() string {
+	return fmt.Sprintf("Range from %v to %v cannot be stepped through", err.From, err.To)
+}
+
+// RangeIterator steps through the numbers in a range the same way a Wren `for` loop does. It is created by `RangeHandle.Iterator`
+type RangeIterator struct {
+	next, to, step float64
+	inclusive      bool
+}
+
+// Next returns the next number in the range, or false once there are none left
+func (it *RangeIterator) Next() (float64, bool) {
+	value := it.next
+	if it.step > 0 && (value > it.to || !it.inclusive && value == it.to) ||
+		it.step < 0 && (value < it.to || !it.inclusive && value == it.to) {
+		return 0, false
+	}
+	it.next += it.step
+	return value, true
+}
+
+// Iterator creates a `RangeIterator` over the numbers in the range. The range counts up by one from `From` towards `To`, or down if `To` is less than `From`. The iterator doesn't use the handle, so it can still be used after the handle is freed. Ranges that can't be stepped through, such as `1..Num.infinity`, return `UnboundedRange`
+func (h *RangeHandle) Iterator() (*RangeIterator, error) {
+	from, to, inclusive, err := h.get()
+	if err != nil {
+		return nil, err
+	}
+	if !steppable(from) || !steppable(to) {
+		return nil, &UnboundedRange{From: from, To: to}
+	}
+	it := &RangeIterator{next: from, to: to, step: 1, inclusive: inclusive}
+	if it.to < it.next {
+		it.step = -1
+	}
+	return it, nil
+}
+
+// steppable returns whether adding one to `n` changes it, which isn't the case for infinity, NaN and numbers from 2^53 on
+func steppable(n float64) bool {
+	return math.Abs(n) < 1<<53
+}
+
+// ToSlice returns every number in the range (see `Iterator`)
+func (h *RangeHandle) ToSlice() ([]float64, error) {
+	it, err := h.Iterator()
+	if err != nil {
+		return nil, err
+	}
+	values := []float64{}
+	for value, ok := it.Next(); ok; value, ok = it.Next() {
+		values = append(values, value)
+	}
+	return values, nil
+}
@@ -0,0 +1,234 @@
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include <stdlib.h>
+#include <string.h>
+#include <stdbool.h>
+#include <stdint.h>
+#include "wren.h"
+
+// Tracks how much memory a VM has allocated. Wren's reallocate function isn't
+// told how big a block was before, so every block starts with a header that
+// holds its size.
+typedef struct {
+	size_t used;
+	size_t peak;
+	// The most memory the VM may use before its script is stopped at the next
+	// checkpoint (0 means there is no limit)
+	size_t limit;
+	// If set, every allocation is reported to the VM's `Config.ReallocateFn`
+	bool notify;
+	// The cgo.Handle of the VM that owns the heap. Wren keeps the heap as its
+	// user data, so callbacks can find their VM without a global lookup
+	uintptr_t vm;
+	// Set (from any thread) when the next checkpoint has to call into Go, such
+	// as to interrupt the script
+	int32_t check;
+	// How many checkpoints the running script has passed (its fuel), and how
+	// many it may pass before calling into Go (0 means there is no limit)
+	int64_t steps;
+	int64_t maxSteps;
+	// If set, every checkpoint calls into Go, such as for `Config.FuelFn`
+	bool everyStep;
+} wrengoHeap;
+
+extern void reallocateFn(void*, size_t, size_t);
+extern char* checkpointFn(void*);
+
+// Keeps the memory after the header aligned for any type
+#define WRENGO_HEADER 16
+
+static void* wrengoReallocate(void* memory, size_t newSize, void* userData) {
+	wrengoHeap* heap = (wrengoHeap*)userData;
+	char* block = NULL;
+	size_t oldSize = 0;
+	if (memory != NULL) {
+		block = (char*)memory - WRENGO_HEADER;
+		oldSize = *(size_t*)block;
+	}
+	if (newSize == 0) {
+		free(block);
+		heap->used -= oldSize;
+		if (heap->notify) {
+			reallocateFn(heap, oldSize, 0);
+		}
+		return NULL;
+	}
+	block = (char*)realloc(block, newSize + WRENGO_HEADER);
+	if (block == NULL) {
+		return NULL;
+	}
+	if (heap->notify) {
+		reallocateFn(heap, oldSize, newSize);
+	}
+	*(size_t*)block = newSize;
+	heap->used = heap->used - oldSize + newSize;
+	if (heap->used > heap->peak) {
+		heap->peak = heap->used;
+	}
+	if (heap->limit > 0 && heap->used > heap->limit) {
+		// Wren crashes if an allocation fails, so the script is stopped at
+		// its next checkpoint instead
+		__atomic_store_n(&heap->check, 1, __ATOMIC_RELEASE);
+	}
+	return block + WRENGO_HEADER;
+}
+
+// Called by the interpreter on every backward jump and method call (WrenGo
+// patches this into wren.c). Most checkpoints only count a step, so Go is only
+// called when there is something to check
+char* wrengoCheckpoint(WrenVM* vm) {
+	wrengoHeap* heap = (wrengoHeap*)wrenGetUserData(vm);
+	heap->steps++;
+	if (__atomic_load_n(&heap->check, __ATOMIC_ACQUIRE) || heap->everyStep ||
+		(heap->maxSteps > 0 && heap->steps > heap->maxSteps)) {
+		return checkpointFn(heap);
+	}
+	return NULL;
+}
+
+// Makes Wren allocate through `heap`
+static void wrengoUseHeap(WrenConfiguration* config, wrengoHeap* heap) {
+	config->reallocateFn = wrengoReallocate;
+	config->userData = heap;
+}
+
+// Copies a string into memory from the VM's allocator so Wren can free it
+static char* wrengoHeapString(wrengoHeap* heap, const char* bytes, size_t length) {
+	char* str = (char*)wrengoReallocate(NULL, length + 1, heap);
+	if (str == NULL) {
+		return NULL;
+	}
+	memcpy(str, bytes, length);
+	str[length] = '\0';
+	return str;
+}
+*/
+import "C"
+import (
+	"fmt"
+	"runtime/cgo"
+	"sync/atomic"
+	"unsafe"
+)
+
+// OutOfMemory is returned if a script is using more memory than `Config.MaxHeapBytes` allows. The limit is only checked after memory is allocated, so `Used` may be far past `Limit`
+type OutOfMemory struct {
+	Used, Limit int64
+}
+
+func (err *OutOfMemory) Error() string {
+	return fmt.Sprintf("Script is using %v bytes of memory but its limit is %v", err.Used, err.Limit)
+}
+
+// heap tracks how much memory a VM has allocated
+type heap C.wrengoHeap
+
+// newHeap allocates the heap that tracks a VM's memory. It is allocated in C because Wren keeps a pointer to it
+func newHeap() *heap {
+	return (*heap)(C.calloc(1, C.size_t(unsafe.Sizeof(heap{}))))
+}
+
+func (h *heap) free() {
+	C.free(unsafe.Pointer(h))
+}
+
+// owner returns the VM that the heap belongs to
+func (h *heap) owner() (*VM, bool) {
+	if h == nil || h.vm == 0 {
+		return nil, false
+	}
+	vm, ok := cgo.Handle(h.vm).Value().(*VM)
+	return vm, ok
+}
+
+// setOwner makes the heap belong to the VM that `handle` holds
+func (h *heap) setOwner(handle cgo.Handle) {
+	h.vm = C.uintptr_t(handle)
+}
+
+// vmFromC finds the VM that Wren called back into from its heap, which Wren keeps as its user data
+func vmFromC(v *C.WrenVM) (*VM, bool) {
+	return (*heap)(C.wrenGetUserData(v)).owner()
+}
+
+// configure makes Wren allocate through the heap and, if `limit` is set, stop the script at its next checkpoint once it goes over the limit and collect garbage often enough that garbage alone doesn't go over it. If `notify` is set, every allocation is reported to `Config.ReallocateFn`
+func (h *heap) configure(config *C.WrenConfiguration, limit int64, notify bool) {
+	C.wrengoUseHeap(config, (*C.wrengoHeap)(h))
+	h.notify = C.bool(notify)
+	h.limit = 0
+	if limit > 0 {
+		h.limit = C.size_t(limit)
+		if half := C.size_t(limit / 2); half < config.initialHeapSize {
+			config.initialHeapSize = half
+		}
+		if half := C.size_t(limit / 2); half < config.minHeapSize {
+			config.minHeapSize = half
+		}
+	}
+}
+
+// requestCheck makes the script call into Go at its next checkpoint (see `checkpoint`). It is safe to call from any goroutine
+func (h *heap) requestCheck() {
+	atomic.StoreInt32((*int32)(unsafe.Pointer(&h.check)), 1)
+}
+
+// clearCheck undoes `requestCheck`
+func (h *heap) clearCheck() {
+	atomic.StoreInt32((*int32)(unsafe.Pointer(&h.check)), 0)
+}
+
+// startSteps resets how many checkpoints the script passed. Once it passes more than `limit` (if set), or at every checkpoint if `everyStep` is set, it calls into Go
+func (h *heap) startSteps(limit int64, everyStep bool) {
+	h.steps = 0
+	h.maxSteps = C.int64_t(limit)
+	h.everyStep = C.bool(everyStep)
+}
+
+// stepCount returns how many checkpoints the script passed
+func (h *heap) stepCount() int64 {
+	return int64(h.steps)
+}
+
+// heapCString copies `str` into memory that Wren can free with its own allocator
+func (vm *VM) heapCString(str string) *C.char {
+	var bytes *C.char
+	if len(str) > 0 {
+		bytes = (*C.char)(unsafe.Pointer(&[]byte(str)[0]))
+	}
+	return C.wrengoHeapString((*C.wrengoHeap)(vm.heap), bytes, C.size_t(len(str)))
+}
+
+// HeapUsed returns how many bytes of memory the VM has allocated, including garbage that hasn't been collected yet
+func (vm *VM) HeapUsed() int64 {
+	if vm.heap == nil {
+		return 0
+	}
+	return int64(vm.heap.used)
+}
+
+// HeapPeak returns the most memory the VM has had allocated at once
+func (vm *VM) HeapPeak() int64 {
+	if vm.heap == nil {
+		return 0
+	}
+	return int64(vm.heap.peak)
+}
+
+// checkHeap returns `OutOfMemory` if the VM is using more memory than `Config.MaxHeapBytes` allows, even after collecting garbage. Wren crashes if an allocation fails, so the allocator only flags that the limit was passed and the script is stopped at its next checkpoint (see `InterpretStringContext`). A single allocation can still go over the limit
+func (vm *VM) checkHeap() error {
+	if vm.Config == nil || vm.Config.MaxHeapBytes <= 0 || vm.heap == nil {
+		return nil
+	}
+	limit := vm.Config.MaxHeapBytes
+	if vm.HeapUsed() <= limit {
+		return nil
+	}
+	vm.GC()
+	if used := vm.HeapUsed(); used > limit {
+		return &OutOfMemory{Used: used, Limit: limit}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package wren
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// Wren does not give embedders a way to stop a running fiber from outside of
+// the VM, so WrenGo patches a checkpoint into Wren's interpreter loop that runs
+// whenever a script jumps back to the top of a loop or calls a method. Every
+// loop and recursion passes through it, even ones that never call into Go. An
+// interrupt makes the next checkpoint abort the fiber and every fiber that
+// called it, so scripts can't keep running by catching it with `Fiber.try()`.
+// Only a single foreign method that doesn't return can't be interrupted.
+
+// Interrupted is returned if a script was interrupted before it finished, such as from a canceled context. `Err` is the reason it was interrupted
+type Interrupted struct {
+	Err error
+}
+
+func (err *Interrupted) Error() string {
+	return fmt.Sprintf("Script was interrupted: %v", err.Err)
+}
+
+func (err *Interrupted) Unwrap() error {
+	return err.Err
+}
+
+// InterruptRequested is the reason a script was interrupted by `VM.Interrupt`
+type InterruptRequested struct{}
+
+func (err *InterruptRequested) Error() string {
+	return "VM.Interrupt was called"
+}
+
+// Interrupt stops the script the VM is running from another goroutine. The interpretation or call that is running returns `Interrupted` (wrapping `InterruptRequested`) once the script reaches its next loop iteration or method call. If the VM isn't running, it does nothing, so it can't stop a later interpretation or call. It is safe to call from any goroutine
+func (vm *VM) Interrupt() {
+	state := &vm.interrupts
+	state.mux.Lock()
+	defer state.mux.Unlock()
+	if state.running {
+		vm.setInterrupt(&Interrupted{Err: &InterruptRequested{}})
+	}
+}
+
+// interruptState is shared between the goroutine running the VM and the ones interrupting it
+type interruptState struct {
+	pending int32
+	mux     sync.Mutex
+	err     error
+	// whether an interpretation or call is running, for `Interrupt`
+	running bool
+}
+
+// interrupt makes the VM abort at its next checkpoint with `err`. Only the first interrupt is kept until it is cleared. It is safe to call from any goroutine
+func (vm *VM) interrupt(err error) {
+	state := &vm.interrupts
+	state.mux.Lock()
+	defer state.mux.Unlock()
+	vm.setInterrupt(err)
+}
+
+// setInterrupt is `interrupt` for callers that hold the lock
+func (vm *VM) setInterrupt(err error) {
+	state := &vm.interrupts
+	if state.err == nil {
+		state.err = err
+		atomic.StoreInt32(&state.pending, 1)
+		vm.heap.requestCheck()
+	}
+}
+
+// checkpoint is called from the interpreter loop when the script is asked to check in (see `requestCheck`). It returns the error to abort the script with, or nil to keep running
+func (vm *VM) checkpoint() error {
+	vm.heap.clearCheck()
+	if err := vm.consumeFuel(); err != nil {
+		vm.interrupt(err)
+	}
+	if err := vm.checkHeap(); err != nil {
+		vm.interrupt(err)
+	}
+	return vm.pendingInterrupt()
+}
+
+// pendingInterrupt returns the error of the pending interrupt or nil if there isn't one
+func (vm *VM) pendingInterrupt() error {
+	state := &vm.interrupts
+	if atomic.LoadInt32(&state.pending) == 0 {
+		return nil
+	}
+	state.mux.Lock()
+	defer state.mux.Unlock()
+	return state.err
+}
+
+// setRunning records whether the VM is running for `Interrupt`. Once it stops, the pending interrupt is removed and returned, so it can't stop the next run
+func (vm *VM) setRunning(running bool) error {
+	state := &vm.interrupts
+	state.mux.Lock()
+	state.running = running
+	state.mux.Unlock()
+	if running {
+		return nil
+	}
+	return vm.clearInterrupt()
+}
+
+// clearInterrupt removes the pending interrupt, returning it
+func (vm *VM) clearInterrupt() error {
+	state := &vm.interrupts
+	state.mux.Lock()
+	defer state.mux.Unlock()
+	err := state.err
+	state.err = nil
+	atomic.StoreInt32(&state.pending, 0)
+	if vm.heap != nil {
+		vm.heap.clearCheck()
+	}
+	return err
+}
+
+// runInterruptible runs `run` (which interprets or calls into Wren) and interrupts it with `Interrupted` if `ctx` is done before it returns
+func (vm *VM) runInterruptible(ctx context.Context, run func() error) error {
+	if err := ctx.Err(); err != nil {
+		return &Interrupted{Err: err}
+	}
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-ctx.Done():
+			vm.interrupt(&Interrupted{Err: ctx.Err()})
+		case <-done:
+		}
+	}()
+	err := run()
+	close(done)
+	<-stopped
+	// an interrupt that arrived after `run` returned has nothing left to stop
+	vm.clearInterrupt()
+	return err
+}
+
+// startRun marks the VM as running at the start of an interpretation or call
+func (vm *VM) startRun() {
+	vm.running = true
+	vm.setRunning(true)
+	vm.startFuel()
+	vm.reported = nil
+	vm.stopWatchdog = vm.startWatchdog()
+}
+
+// finishRun marks the VM as stopped and attaches the errors Wren reported to `err`. If the run was interrupted, the interrupt's error is returned instead
+func (vm *VM) finishRun(err error) error {
+	vm.running = false
+	vm.stopWatchdog()
+	vm.flushLine()
+	err = vm.attachReported(err)
+	if cause := vm.setRunning(false); cause != nil {
+		return cause
+	}
+	if heapErr := vm.checkHeap(); heapErr != nil {
+		return heapErr
+	}
+	return err
+}
+
+// InterpretStringContext is like `InterpretString` but interrupts the script if `ctx` is canceled or its deadline passes, returning `Interrupted`. The script is stopped at its next loop iteration or method call
+func (vm *VM) InterpretStringContext(ctx context.Context, module, source string) (err error) {
+	if vm.onThread(func() { err = vm.InterpretStringContext(ctx, module, source) }) {
+		return err
+	}
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	if vm.running {
+		return &RunningVMError{}
+	}
+	return vm.runInterruptible(ctx, func() error {
+		return vm.InterpretString(module, source)
+	})
+}
+
+// CallContext is like `Call` but interrupts the function if `ctx` is canceled or its deadline passes, returning `Interrupted`. Like `InterpretStringContext`, the function is stopped at its next loop iteration or method call
+func (h *CallHandle) CallContext(ctx context.Context, parameters ...interface{}) (value interface{}, err error) {
+	if h.handle.onThread(func() { value, err = h.CallContext(ctx, parameters...) }) {
+		return value, err
+	}
+	vm := h.handle.vm
+	if vm.running {
+		return nil, &RunningVMError{}
+	}
+	var result interface{}
+	err = vm.runInterruptible(ctx, func() error {
+		var err error
+		result, err = h.Call(parameters...)
+		return err
+	})
+	if err != nil {
+		vm.FreeAll(result)
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,93 @@
+package wren
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// InvalidJSONNumber is returned from `Json.stringify` if a number is NaN or infinite, which JSON cannot represent
+type InvalidJSONNumber struct {
+	Value float64
+}
+
+func (err *InvalidJSONNumber) Error() string {
+	return fmt.Sprintf("%v cannot be converted to JSON", err.Value)
+}
+
+// NewJSONModule creates a module with a `Json` class so scripts can read and write JSON. It is usually set as the "json" module:
+//
+//	vm.SetModule("json", wren.NewJSONModule())
+//
+// Scripts can then use `Json.parse(text)` to turn JSON into Wren maps, lists, strings, numbers, bools and null, and `Json.stringify(value)` (or `Json.stringify(value, indent)`) to turn those back into JSON. Map keys must be strings to be stringified.
+func NewJSONModule() *Module {
+	module := NewModule(ClassMap{
+		"Json": NewClass(nil, nil, MethodMap{
+			"static parse(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				text, ok := parameters[1].(string)
+				if !ok {
+					return nil, &UnexpectedValue{Value: parameters[1]}
+				}
+				var value interface{}
+				if err := json.Unmarshal([]byte(text), &value); err != nil {
+					return nil, err
+				}
+				return vm.returnValue(reflect.ValueOf(value))
+			},
+			"static encodeString_(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				var buffer bytes.Buffer
+				encoder := json.NewEncoder(&buffer)
+				encoder.SetEscapeHTML(false)
+				if err := encoder.Encode(parameters[1]); err != nil {
+					return nil, err
+				}
+				return string(bytes.TrimSuffix(buffer.Bytes(), []byte("\n"))), nil
+			},
+			"static encodeNum_(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				number, _ := parameters[1].(float64)
+				if math.IsNaN(number) || math.IsInf(number, 0) {
+					return nil, &InvalidJSONNumber{Value: number}
+				}
+				data, err := json.Marshal(number)
+				return string(data), err
+			},
+			"static indent_(_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				text, _ := parameters[1].(string)
+				indent, _ := parameters[2].(string)
+				var buffer bytes.Buffer
+				if err := json.Indent(&buffer, []byte(text), "", indent); err != nil {
+					return nil, err
+				}
+				return buffer.String(), nil
+			},
+		}),
+	})
+	// Wren maps can't be iterated from Go while a script is running, so values are walked in Wren and only strings and numbers are encoded in Go
+	module.Source = `
+class Json {
+	foreign static parse(text)
+	static stringify(value, indent) { indent_(stringify(value), indent) }
+	static stringify(value) {
+		if (value is Map) {
+			var entries = []
+			for (key in value.keys) {
+				if (!(key is String)) Fiber.abort("JSON object keys must be strings, not %(key.type)")
+				entries.add(encodeString_(key) + ":" + stringify(value[key]))
+			}
+			return "{" + entries.join(",") + "}"
+		}
+		if (value is List) return "[" + value.map {|element| stringify(element) }.join(",") + "]"
+		if (value is String) return encodeString_(value)
+		if (value is Num) return encodeNum_(value)
+		if (value is Bool || value == null) return value.toString
+		Fiber.abort("%(value.type) cannot be converted to JSON")
+	}
+	foreign static encodeString_(value)
+	foreign static encodeNum_(value)
+	foreign static indent_(text, indent)
+}
+`
+	return module
+}
@@ -0,0 +1,266 @@
+package wren
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// taskQueue holds the work `Poll` runs for the event loop. It is the only part of the loop that can be used from other goroutines
+type taskQueue struct {
+	mux   sync.Mutex
+	tasks []func(vm *VM) error
+	// signalled when a task is added so `RunContext` stops waiting
+	wake chan struct{}
+	// how many `Async` calls are still working
+	working int
+	// changes when the VM is reset so work started before it isn't finished in the new VM
+	generation int
+}
+
+func newTaskQueue() *taskQueue {
+	return &taskQueue{wake: make(chan struct{}, 1)}
+}
+
+func (q *taskQueue) push(task func(vm *VM) error) {
+	q.mux.Lock()
+	q.tasks = append(q.tasks, task)
+	q.mux.Unlock()
+	q.signal()
+}
+
+// signal wakes `RunContext` up if it is waiting
+func (q *taskQueue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// take removes every queued task
+func (q *taskQueue) take() []func(vm *VM) error {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	tasks := q.tasks
+	q.tasks = nil
+	return tasks
+}
+
+func (q *taskQueue) pending() int {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	return len(q.tasks) + q.working
+}
+
+// reset drops queued tasks and forgets work that is still running
+func (q *taskQueue) reset() {
+	q.mux.Lock()
+	defer q.mux.Unlock()
+	q.tasks = nil
+	q.working = 0
+	q.generation++
+}
+
+// Post queues `task` to be run by the next `Poll` (or by `Run`, which wakes up for it). Unlike the rest of the VM, it is safe to call from any goroutine, so it is how work finished elsewhere (such as I/O) is handed back to the VM. If `task` returns an error, `Poll` returns it
+func (vm *VM) Post(task func(vm *VM) error) {
+	vm.tasks.push(task)
+}
+
+// Enqueue makes the next `Poll` resume `fiber`, sending it `value` as the result of the `Fiber.suspend()` it is waiting in. Fibers can't be resumed while the VM is running, so this lets foreign methods resume other fibers once they return. `value` is converted with `Marshal` when the fiber is resumed
+func (vm *VM) Enqueue(fiber *FiberHandle, value interface{}) error {
+	fiber, err := fiber.Copy()
+	if err != nil {
+		return err
+	}
+	vm.tasks.push(func(vm *VM) error {
+		return vm.resumeWith(fiber, value)
+	})
+	return nil
+}
+
+// Async runs `work` on its own goroutine and resumes `fiber` with what it returns once it is done, so foreign methods can do slow work (such as I/O) without blocking the VM. If `work` returns an error, the fiber is resumed with it as a runtime error instead. The foreign method should be called by a Wren method that suspends the fiber it passed afterwards:
+//
+//	static read(path) {
+//		read_(path, Fiber.current)
+//		return Fiber.suspend()
+//	}
+//
+// The fiber is resumed by `Poll` or `Run`, which keep waiting for work that hasn't finished
+func (vm *VM) Async(fiber *FiberHandle, work func() (interface{}, error)) error {
+	fiber, err := fiber.Copy()
+	if err != nil {
+		return err
+	}
+	q := vm.tasks
+	q.mux.Lock()
+	q.working++
+	generation := q.generation
+	q.mux.Unlock()
+	go func() {
+		value, workErr := work()
+		q.mux.Lock()
+		if generation != q.generation {
+			// the fiber belonged to the VM before it was reset and was already freed
+			q.mux.Unlock()
+			return
+		}
+		// the work stops counting as working and is queued at once so `Pending` never misses it in between
+		q.working--
+		q.tasks = append(q.tasks, func(vm *VM) error {
+			if workErr != nil {
+				return vm.abortFiber(fiber, workErr)
+			}
+			return vm.resumeWith(fiber, value)
+		})
+		q.mux.Unlock()
+		q.signal()
+	}()
+	return nil
+}
+
+// Pending returns how many things the event loop is waiting for: sleeping timers, fibers receiving from or sending to Go channels, `Async` work that hasn't finished and queued tasks. `Run` returns once this is 0
+func (vm *VM) Pending() int {
+	return len(vm.timers) + len(vm.receivers) + len(vm.senders) + vm.tasks.pending()
+}
+
+// Poll runs the event loop once without waiting: it runs queued tasks (see `Post`, `Enqueue` and `Async`), then resumes every fiber whose `Timer.sleep` has finished or whose Go channel has a value for it (see `NewChannel`). Fibers that wait again while being resumed wait for the next poll. If a task returns an error or a resumed fiber aborts, the error is returned and the work that was still ready is done by the next poll
+func (vm *VM) Poll() (err error) {
+	if vm.onThread(func() { err = vm.Poll() }) {
+		return err
+	}
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	if vm.running {
+		return &RunningVMError{}
+	}
+	tasks := vm.tasks.take()
+	for i, task := range tasks {
+		if err := task(vm); err != nil {
+			vm.tasks.mux.Lock()
+			vm.tasks.tasks = append(tasks[i+1:len(tasks):len(tasks)], vm.tasks.tasks...)
+			vm.tasks.mux.Unlock()
+			return err
+		}
+	}
+	now := time.Now()
+	due := sort.Search(len(vm.timers), func(i int) bool {
+		return vm.timers[i].at.After(now)
+	})
+	for ; due > 0; due-- {
+		next := vm.timers[0]
+		vm.timers = vm.timers[1:]
+		err := vm.resume(next.fiber)
+		if err != nil {
+			return err
+		}
+	}
+	if err := vm.pollReceivers(); err != nil {
+		return err
+	}
+	return vm.pollSenders()
+}
+
+// resume continues a suspended fiber, sending it `value` if one is given. The fiber is freed afterwards
+func (vm *VM) resume(fiber *FiberHandle, value ...interface{}) error {
+	defer fiber.Free()
+	fn, err := fiber.Func(methodSignature("transfer", len(value)))
+	if err != nil {
+		return err
+	}
+	defer fn.Free()
+	_, err = fn.Call(value...)
+	return err
+}
+
+// resumeWith converts a Go value with `Marshal` and resumes `fiber` with it
+func (vm *VM) resumeWith(fiber *FiberHandle, value interface{}) error {
+	converted, err := vm.Marshal(value)
+	if err != nil {
+		fiber.Free()
+		return err
+	}
+	defer vm.FreeAll(converted)
+	if converted == nil {
+		converted = Null
+	}
+	return vm.resume(fiber, converted)
+}
+
+// abortFiber resumes a suspended fiber with `err` as a runtime error. The fiber is freed afterwards
+func (vm *VM) abortFiber(fiber *FiberHandle, err error) error {
+	defer fiber.Free()
+	fn, ferr := fiber.Func("transferError(_)")
+	if ferr != nil {
+		return ferr
+	}
+	defer fn.Free()
+	_, ferr = fn.Call(err.Error())
+	return ferr
+}
+
+// Run is the event loop. It polls as timers finish, channels receive values and tasks are queued, blocking in between, until nothing is pending
+func (vm *VM) Run() error {
+	return vm.RunContext(context.Background())
+}
+
+// RunContext is like `Run` but stops waiting and returns `ctx.Err()` once `ctx` is done
+func (vm *VM) RunContext(ctx context.Context) error {
+	for vm.Pending() > 0 {
+		cases := []reflect.SelectCase{
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())},
+			{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(vm.tasks.wake)},
+		}
+		var wait *time.Timer
+		if len(vm.timers) > 0 {
+			wait = time.NewTimer(time.Until(vm.timers[0].at))
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(wait.C)})
+		}
+		first := len(cases)
+		for _, r := range vm.receivers {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: r.channel.ch})
+		}
+		senders := len(cases)
+		for _, s := range vm.senders {
+			cases = append(cases, reflect.SelectCase{Dir: reflect.SelectSend, Chan: s.channel.ch, Send: s.value})
+		}
+		chosen, value, ok := selectCases(cases)
+		if wait != nil {
+			wait.Stop()
+		}
+		if chosen == 0 {
+			return ctx.Err()
+		}
+		if chosen >= senders {
+			// the value was sent so the fiber that was waiting is done
+			s := vm.senders[chosen-senders]
+			vm.senders = append(vm.senders[:chosen-senders:chosen-senders], vm.senders[chosen-senders+1:]...)
+			if err := vm.resume(s.fiber); err != nil {
+				return err
+			}
+		} else if chosen >= first {
+			// the value was taken from the channel so it has to go to the fiber that was waiting for it
+			r := vm.receivers[chosen-first]
+			vm.receivers = append(vm.receivers[:chosen-first:chosen-first], vm.receivers[chosen-first+1:]...)
+			if err := vm.deliver(r, value, ok); err != nil {
+				return err
+			}
+		}
+		if err := vm.Poll(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectCases waits for one of `cases` like `reflect.Select`. If a channel being sent to was closed, -1 is returned instead of panicking, so `Poll` can abort the fiber that was sending
+func selectCases(cases []reflect.SelectCase) (chosen int, value reflect.Value, ok bool) {
+	defer func() {
+		if recover() != nil {
+			chosen = -1
+		}
+	}()
+	return reflect.Select(cases)
+}
@@ -0,0 +1,245 @@
+package wren
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ChannelDenied is returned to scripts that open a channel their VM wasn't given by `Mailbox.Module`
+type ChannelDenied struct {
+	Channel string
+}
+
+func (err *ChannelDenied) Error() string {
+	return fmt.Sprintf("This VM was not given the channel \"%v\"", err.Channel)
+}
+
+// Mailbox holds named channels of messages that can be passed between Go and the scripts of any number of VMs, such as VMs running as actors on their own goroutines. Messages are deep copied when they are posted, so they can be lists, maps, strings, numbers, bools and null but not values that belong to a single VM like foreign objects. A mailbox is safe for concurrent use
+type Mailbox struct {
+	mux    sync.Mutex
+	queues map[string][]interface{}
+}
+
+// NewMailbox creates a mailbox with no messages
+func NewMailbox() *Mailbox {
+	return &Mailbox{queues: make(map[string][]interface{})}
+}
+
+// Post copies `value` and adds it to the end of `channel`. `value` can be a Wren value (including list and map handles) or Go values made of slices, maps, strings, numbers and bools
+func (m *Mailbox) Post(channel string, value interface{}) error {
+	return m.post(channel, value, (*MapHandle).Keys)
+}
+
+// post copies `value` like `Post`, getting the keys of Wren maps from `keys`
+func (m *Mailbox) post(channel string, value interface{}, keys func(*MapHandle) ([]interface{}, error)) error {
+	copied, err := detachValue(value, keys)
+	if err != nil {
+		return err
+	}
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.queues[channel] = append(m.queues[channel], copied)
+	return nil
+}
+
+// Receive removes the first message from `channel`. Lists and maps are returned as `[]interface{}` and `map[interface{}]interface{}`. `ok` is false if the channel has no messages
+func (m *Mailbox) Receive(channel string) (value interface{}, ok bool) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	queue := m.queues[channel]
+	if len(queue) == 0 {
+		return nil, false
+	}
+	value = queue[0]
+	queue[0] = nil
+	if len(queue) == 1 {
+		delete(m.queues, channel)
+	} else {
+		m.queues[channel] = queue[1:]
+	}
+	return value, true
+}
+
+// Count returns how many messages are waiting in `channel`
+func (m *Mailbox) Count(channel string) int {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return len(m.queues[channel])
+}
+
+// Module creates a module with a `Channel` class that lets scripts use the mailbox's channels. If `channels` are given, scripts can only open those, otherwise they can open any channel:
+//
+//	vm.SetModule("mailbox", mailbox.Module("jobs", "results"))
+//
+// Scripts open a channel with `Channel.open(name)`, post messages with `send(value)`, and take them with `receive()`, which returns null if there are no messages. `count` and `isEmpty` tell how many messages are waiting.
+func (m *Mailbox) Module(channels ...string) *Module {
+	allowed := make(map[string]bool, len(channels))
+	for _, channel := range channels {
+		allowed[channel] = true
+	}
+	check := func(channel string) error {
+		if len(allowed) > 0 && !allowed[channel] {
+			return &ChannelDenied{Channel: channel}
+		}
+		return nil
+	}
+	module := NewModule(ClassMap{
+		"Channel": NewClass(nil, nil, MethodMap{
+			"static check_(_)": Method1(func(vm *VM, channel string) (interface{}, error) {
+				return Null, check(channel)
+			}),
+			"static send_(_,_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				channel, err := decodeArg[string](vm, parameters, 1)
+				if err == nil {
+					err = check(channel)
+				}
+				if err != nil {
+					return nil, err
+				}
+				lists, ok := parameters[3].(*ListHandle)
+				if !ok {
+					return nil, &UnexpectedValue{Value: parameters[3]}
+				}
+				listed, err := lists.ToSlice(false)
+				if err != nil {
+					return nil, err
+				}
+				defer vm.FreeAll(listed...)
+				return Null, m.post(channel, parameters[2], listedKeys(listed))
+			},
+			"static receive_(_)": Method1(func(vm *VM, channel string) (interface{}, error) {
+				if err := check(channel); err != nil {
+					return nil, err
+				}
+				value, _ := m.Receive(channel)
+				return vm.Return(value)
+			}),
+			"static count_(_)": Method1(func(vm *VM, channel string) (interface{}, error) {
+				return m.Count(channel), check(channel)
+			}),
+		}),
+	})
+	module.Source = `
+class Channel {
+	construct open(name) {
+		Channel.check_(name)
+		_name = name
+	}
+	name { _name }
+	send(value) { Channel.send_(_name, value, Channel.keys_(value, [], [])) }
+	receive() { Channel.receive_(_name) }
+	count { Channel.count_(_name) }
+	isEmpty { count == 0 }
+	foreign static check_(name)
+	// Lists the keys of every map in value in the order they are copied, since
+	// the keys of maps can't be read from Go while the script is running
+	static keys_(value, keys, parents) {
+		if (!(value is List || value is Map) || parents.any {|parent| Object.same(parent, value) }) return keys
+		parents.add(value)
+		if (value is Map) {
+			var mapKeys = value.keys.toList
+			keys.add(mapKeys)
+			for (key in mapKeys) Channel.keys_(value[key], keys, parents)
+		} else {
+			for (element in value) Channel.keys_(element, keys, parents)
+		}
+		parents.removeAt(-1)
+		return keys
+	}
+	foreign static send_(name, value, keys)
+	foreign static receive_(name)
+	foreign static count_(name)
+}
+`
+	return module
+}
+
+// listedKeys returns the keys of each map from the lists that `Channel.keys_` made, one list at a time
+func listedKeys(listed []interface{}) func(*MapHandle) ([]interface{}, error) {
+	return func(m *MapHandle) ([]interface{}, error) {
+		if len(listed) == 0 {
+			return nil, &UnexpectedValue{Value: m}
+		}
+		list, ok := listed[0].(*ListHandle)
+		if !ok {
+			return nil, &UnexpectedValue{Value: listed[0]}
+		}
+		listed = listed[1:]
+		return list.ToSlice(false)
+	}
+}
+
+// detachValue deep copies a Wren or Go value into plain Go values that don't belong to any VM, getting the keys of Wren maps from `keys`
+func detachValue(value interface{}, keys func(*MapHandle) ([]interface{}, error)) (interface{}, error) {
+	switch value := value.(type) {
+	case *ListHandle:
+		return value.VM().detach(value, nil, keys)
+	case *MapHandle:
+		return value.VM().detach(value, nil, keys)
+	case nullValue:
+		return nil, nil
+	case freeableHandle:
+		return nil, &InvalidValue{Value: value}
+	}
+	return detachGo(reflect.ValueOf(value))
+}
+
+func detachGo(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if _, ok := v.Interface().(freeableHandle); ok {
+			return detachValue(v.Interface(), (*MapHandle).Keys)
+		}
+		return detachGo(v.Elem())
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 && v.Kind() == reflect.Slice {
+			return string(v.Bytes()), nil
+		}
+		copied := make([]interface{}, v.Len())
+		for i := range copied {
+			element, err := detachGo(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			copied[i] = element
+		}
+		return copied, nil
+	case reflect.Map:
+		copied := make(map[interface{}]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := detachGo(iter.Key())
+			if err != nil {
+				return nil, err
+			}
+			switch key.(type) {
+			case nil, bool, float64, string:
+			default:
+				return nil, &InvalidKey{Key: key}
+			}
+			element, err := detachGo(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			copied[key] = element
+		}
+		return copied, nil
+	}
+	return nil, &InvalidValue{Value: v.Interface()}
+}
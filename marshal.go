@@ -0,0 +1,426 @@
+package wren
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// UnmarshalTypeError is returned from `Unmarshal` if a Wren value cannot be stored in a Go value of type `Type`
+type UnmarshalTypeError struct {
+	// Description of the Wren value, such as "string" or "list"
+	Value string
+	Type  reflect.Type
+	// Path to the value that failed, such as "Servers[1].Port". Empty if the top level value failed
+	Field string
+}
+
+func (err *UnmarshalTypeError) Error() string {
+	if err.Field != "" {
+		return fmt.Sprintf("Cannot unmarshal Wren %v into field \"%v\" of Go type %v", err.Value, err.Field, err.Type)
+	}
+	return fmt.Sprintf("Cannot unmarshal Wren %v into Go type %v", err.Value, err.Type)
+}
+
+// fieldInfo is how a struct field is named in Wren
+type fieldInfo struct {
+	name      string
+	index     int
+	omitEmpty bool
+}
+
+// structFields returns the exported fields of `t` that aren't tagged with `wren:"-"`. Tags are written like `wren:"name,omitempty"`
+func structFields(t reflect.Type) []fieldInfo {
+	var fields []fieldInfo
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		info := fieldInfo{name: field.Name, index: i}
+		if tag, ok := field.Tag.Lookup("wren"); ok {
+			if tag == "-" {
+				continue
+			}
+			options := strings.Split(tag, ",")
+			if options[0] != "" {
+				info.name = options[0]
+			}
+			for _, option := range options[1:] {
+				if option == "omitempty" {
+					info.omitEmpty = true
+				}
+			}
+		}
+		fields = append(fields, info)
+	}
+	return fields
+}
+
+// Marshal converts a Go value into a Wren value. Structs become maps keyed by their field names (or the name in a `wren:"name"` tag), slices and arrays become lists, maps become maps, and numbers become float64. `time.Time` values are kept as they are and become `DateTime` objects once they are passed to Wren. Pointers and interfaces are followed and nil becomes null. Lists and maps are returned as handles that should be freed
+func (vm *VM) Marshal(value interface{}) (converted interface{}, err error) {
+	if vm.onThread(func() { converted, err = vm.Marshal(value) }) {
+		return converted, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
+	}
+	return vm.marshal(reflect.ValueOf(value))
+}
+
+func (vm *VM) marshal(v reflect.Value) (interface{}, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	switch value := v.Interface().(type) {
+	case *Handle:
+		return value.Copy()
+	case *ListHandle:
+		return value.Copy()
+	case *MapHandle:
+		return value.Copy()
+	case *ForeignHandle:
+		return value.Copy()
+	case *FiberHandle:
+		return value.Copy()
+	case *ClassHandle:
+		return value.Copy()
+	case *FnHandle:
+		return value.Copy()
+	case *RangeHandle:
+		return value.Copy()
+	case []byte:
+		return string(value), nil
+	case time.Time:
+		// turned into a `DateTime` object when it is written to a slot
+		return value, nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return vm.marshal(v.Elem())
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), nil
+	case reflect.Slice, reflect.Array:
+		list, err := vm.NewList()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := vm.marshalInto(v.Index(i), list.Insert); err != nil {
+				list.Free()
+				return nil, err
+			}
+		}
+		return list, nil
+	case reflect.Map:
+		m, err := vm.NewMap()
+		if err != nil {
+			return nil, err
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := vm.marshal(iter.Key())
+			if err == nil {
+				err = vm.marshalInto(iter.Value(), func(value interface{}) error {
+					return m.Set(key, value)
+				})
+				vm.FreeAll(key)
+			}
+			if err != nil {
+				m.Free()
+				return nil, err
+			}
+		}
+		return m, nil
+	case reflect.Struct:
+		m, err := vm.NewMap()
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range structFields(v.Type()) {
+			fieldValue := v.Field(field.index)
+			if field.omitEmpty && fieldValue.IsZero() {
+				continue
+			}
+			err := vm.marshalInto(fieldValue, func(value interface{}) error {
+				return m.Set(field.name, value)
+			})
+			if err != nil {
+				m.Free()
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+	return nil, &InvalidValue{Value: v.Interface()}
+}
+
+// marshalInto marshals `v` and passes it to `set`, freeing it afterwards
+func (vm *VM) marshalInto(v reflect.Value, set func(interface{}) error) error {
+	value, err := vm.marshal(v)
+	if err != nil {
+		return err
+	}
+	defer vm.FreeAll(value)
+	if value == nil {
+		value = Null
+	}
+	return set(value)
+}
+
+// Unmarshal stores a Wren value (as returned by WrenGo or `ListHandle.ToSlice` and `MapHandle.ToMap`) in the Go value that `out` points to. Maps are stored into structs by their field names (or the name in a `wren:"name"` tag) and keys without a matching field are ignored. Numbers can only be stored in integers if they don't have a fraction and fit. Values stored in an empty interface are converted to Go slices and maps recursively. Reading Wren maps calls into Wren, so maps can't be unmarshaled while the VM is running
+func (vm *VM) Unmarshal(value interface{}, out interface{}) (err error) {
+	if vm.onThread(func() { err = vm.Unmarshal(value, out) }) {
+		return err
+	}
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return &InvalidValue{Value: out}
+	}
+	return vm.unmarshal(value, v.Elem(), "")
+}
+
+func describeValue(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case *ListHandle, []interface{}:
+		return "list"
+	case *MapHandle, map[interface{}]interface{}:
+		return "map"
+	case *ForeignHandle:
+		return "foreign object"
+	case *FiberHandle:
+		return "fiber"
+	case *ClassHandle:
+		return "class"
+	case *FnHandle:
+		return "function"
+	case *RangeHandle:
+		return "range"
+	}
+	return "object"
+}
+
+func joinField(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+func (vm *VM) unmarshal(value interface{}, v reflect.Value, path string) error {
+	mismatch := func() error {
+		return &UnmarshalTypeError{Value: describeValue(value), Type: v.Type(), Field: path}
+	}
+	if foreign, ok := value.(*ForeignHandle); ok {
+		goValue, err := foreign.Get()
+		if err != nil {
+			return err
+		}
+		if goValue != nil && reflect.TypeOf(goValue).AssignableTo(v.Type()) {
+			v.Set(reflect.ValueOf(goValue))
+			return nil
+		}
+	}
+	if v.Kind() != reflect.Interface && value != nil && reflect.TypeOf(value).AssignableTo(v.Type()) {
+		// handles and values that already have the right type
+		v.Set(reflect.ValueOf(value))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr:
+		if value == nil {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return vm.unmarshal(value, v.Elem(), path)
+	case reflect.Interface:
+		if v.NumMethod() != 0 {
+			break
+		}
+		converted, err := vm.unmarshalAny(value)
+		if err != nil {
+			return err
+		}
+		if converted == nil {
+			v.Set(reflect.Zero(v.Type()))
+		} else {
+			v.Set(reflect.ValueOf(converted))
+		}
+		return nil
+	case reflect.Bool:
+		if b, ok := value.(bool); ok {
+			v.SetBool(b)
+			return nil
+		}
+	case reflect.String:
+		if s, ok := value.(string); ok {
+			v.SetString(s)
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, ok := value.(float64); ok && !v.OverflowFloat(n) {
+			v.SetFloat(n)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := value.(float64); ok && n == math.Trunc(n) && !v.OverflowInt(int64(n)) {
+			v.SetInt(int64(n))
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if n, ok := value.(float64); ok && n >= 0 && n == math.Trunc(n) && !v.OverflowUint(uint64(n)) {
+			v.SetUint(uint64(n))
+			return nil
+		}
+	case reflect.Slice:
+		if s, ok := value.(string); ok && v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(s))
+			return nil
+		}
+		elements, free, ok, err := vm.unmarshalList(value)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		defer free()
+		slice := reflect.MakeSlice(v.Type(), len(elements), len(elements))
+		for i, element := range elements {
+			if err := vm.unmarshal(element, slice.Index(i), fmt.Sprintf("%v[%v]", path, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+		return nil
+	case reflect.Array:
+		elements, free, ok, err := vm.unmarshalList(value)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		defer free()
+		if len(elements) != v.Len() {
+			return mismatch()
+		}
+		for i, element := range elements {
+			if err := vm.unmarshal(element, v.Index(i), fmt.Sprintf("%v[%v]", path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		entries, free, ok, err := vm.unmarshalMap(value)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		defer free()
+		m := reflect.MakeMapWithSize(v.Type(), len(entries))
+		for key, value := range entries {
+			k := reflect.New(v.Type().Key()).Elem()
+			if err := vm.unmarshal(key, k, path); err != nil {
+				return err
+			}
+			e := reflect.New(v.Type().Elem()).Elem()
+			if err := vm.unmarshal(value, e, joinField(path, fmt.Sprint(key))); err != nil {
+				return err
+			}
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+		return nil
+	case reflect.Struct:
+		entries, free, ok, err := vm.unmarshalMap(value)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		defer free()
+		for _, field := range structFields(v.Type()) {
+			if value, ok := entries[field.name]; ok {
+				if err := vm.unmarshal(value, v.Field(field.index), joinField(path, field.name)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return mismatch()
+}
+
+// unmarshalList returns the elements of a list handle or slice. `free` releases any handles that were created while reading them
+func (vm *VM) unmarshalList(value interface{}) (elements []interface{}, free func(), ok bool, err error) {
+	switch value := value.(type) {
+	case *ListHandle:
+		elements, err := value.ToSlice(false)
+		return elements, func() { vm.FreeAll(elements...) }, true, err
+	case *RangeHandle:
+		numbers, err := value.ToSlice()
+		elements := make([]interface{}, len(numbers))
+		for i, number := range numbers {
+			elements[i] = number
+		}
+		return elements, func() {}, true, err
+	case []interface{}:
+		return value, func() {}, true, nil
+	}
+	return nil, nil, false, nil
+}
+
+// unmarshalMap returns the entries of a map handle or Go map. `free` releases any handles that were created while reading them
+func (vm *VM) unmarshalMap(value interface{}) (entries map[interface{}]interface{}, free func(), ok bool, err error) {
+	switch value := value.(type) {
+	case *MapHandle:
+		entries, err := value.ToMap(false)
+		return entries, func() {
+			for key, value := range entries {
+				vm.FreeAll(key, value)
+			}
+		}, true, err
+	case map[interface{}]interface{}:
+		return value, func() {}, true, nil
+	}
+	return nil, nil, false, nil
+}
+
+// unmarshalAny converts lists and maps into Go slices and maps without freeing the handle passed in
+func (vm *VM) unmarshalAny(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case *ListHandle:
+		return value.ToSlice(true)
+	case *MapHandle:
+		return value.ToMap(true)
+	}
+	return value, nil
+}
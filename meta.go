@@ -0,0 +1,120 @@
+package wren
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ModuleDisabled is what imports of one of Wren's optional modules are aborted with if the VM's config doesn't enable it
+type ModuleDisabled struct {
+	Module string
+}
+
+func (err *ModuleDisabled) Error() string {
+	return fmt.Sprintf("Module \"%v\" is disabled for this VM", err.Module)
+}
+
+// EvalError is what `Meta.eval` aborts with if its source fails to compile. `Diagnostics` holds the compile errors, which are also sent to `ErrorFn`
+type EvalError struct {
+	Diagnostics []*CompileError
+}
+
+func (err *EvalError) Error() string {
+	messages := make([]string, len(err.Diagnostics))
+	for i, diagnostic := range err.Diagnostics {
+		messages[i] = diagnostic.Error()
+	}
+	return "Could not compile source code: " + strings.Join(messages, "; ")
+}
+
+// metaModule is the source of the "meta" module. It is Wren's own except that compile errors are always reported so `eval` can abort with them. `compile_` finds the module to compile in from the method that called it, so it has to be called directly from the methods scripts call
+const metaModule = `
+class Meta {
+	static getModuleVariables(module) {
+		if (!(module is String)) Fiber.abort("Module name must be a string.")
+		var result = getModuleVariables_(module)
+		if (result != null) return result
+		Fiber.abort("Could not find a module named '%(module)'.")
+	}
+
+	static eval(source) {
+		if (!(source is String)) Fiber.abort("Source code must be a string.")
+		var mark = mark_()
+		var closure = compile_(source, false, true)
+		if (closure == null) failed_(mark)
+		closure.call()
+	}
+
+	static compileExpression(source) {
+		if (!(source is String)) Fiber.abort("Source code must be a string.")
+		return compile_(source, true, true)
+	}
+
+	static compile(source) {
+		if (!(source is String)) Fiber.abort("Source code must be a string.")
+		return compile_(source, false, true)
+	}
+
+	foreign static compile_(source, isExpression, printErrors)
+	foreign static getModuleVariables_(module)
+	foreign static mark_()
+	foreign static failed_(mark)
+}
+`
+
+// metaModuleDefinition creates the "meta" module. `compile_` and `getModuleVariables_` aren't in its methods, so Wren binds its own
+func metaModuleDefinition() *Module {
+	module := NewModule(ClassMap{
+		"Meta": NewClass(nil, nil, MethodMap{
+			"static mark_()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return len(vm.reported), nil
+			},
+			"static failed_(_)": Method1(func(vm *VM, mark int) (interface{}, error) {
+				err := &EvalError{}
+				if mark <= len(vm.reported) {
+					for _, reported := range vm.reported[mark:] {
+						if diagnostic, ok := reported.(*CompileError); ok {
+							err.Diagnostics = append(err.Diagnostics, diagnostic)
+						}
+					}
+				}
+				return nil, err
+			}),
+		}),
+	})
+	module.Source = metaModule
+	return module
+}
+
+// optionalModuleEnabled returns whether scripts may import Wren's optional module `name` ("meta" or "random")
+func (vm *VM) optionalModuleEnabled(name string) bool {
+	switch name {
+	case "meta":
+		return vm.Config != nil && vm.Config.EnableMeta
+	case "random":
+		return vm.Config == nil || !vm.Config.DisableRandom
+	}
+	return false
+}
+
+// isBuiltinModule returns whether `name` is one of Wren's optional modules
+func isBuiltinModule(name string) bool {
+	return name == "meta" || name == "random"
+}
+
+// loadBuiltinModule loads Wren's optional modules. If `name` isn't one of them, `ok` is false. Returning no source would make Wren load its own version of the module, so disabled modules load source that aborts the import instead
+func (vm *VM) loadBuiltinModule(name string) (source string, ok bool) {
+	if !isBuiltinModule(name) {
+		return "", false
+	}
+	if !vm.optionalModuleEnabled(name) {
+		return fmt.Sprintf("Fiber.abort(%q)", (&ModuleDisabled{Module: name}).Error()), true
+	}
+	if name == "random" {
+		// Wren loads and binds its own
+		return "", false
+	}
+	module := metaModuleDefinition()
+	vm.setModule(name, module)
+	return module.source(), true
+}
@@ -0,0 +1,75 @@
+package wren
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// MethodCall describes a call to a foreign method for `Config.MethodObserver`
+type MethodCall struct {
+	// The module the foreign class is in
+	Module string
+	Class  string
+	// Signature of the foreign method ("<allocate>" for foreign constructors)
+	Signature string
+	// How long the foreign method took to return
+	Duration time.Duration
+	// The error the method returned, which the fiber was aborted with
+	Err error
+}
+
+// MethodObserver is called after every foreign method call
+type MethodObserver func(vm *VM, call MethodCall)
+
+// Stats counts what a VM has done since it was created. It is returned from `VM.Stats`
+type Stats struct {
+	// Calls to `InterpretString` and the functions built on it
+	Interprets int64
+	// Calls into Wren from Go through call handles
+	Calls int64
+	// Calls from Wren into foreign methods
+	ForeignCalls int64
+	// Fibers aborted from Go, such as by a foreign method returning an error
+	Aborts int64
+	// Garbage collections started from Go, such as by `GC` or to check `Config.MaxHeapBytes`
+	GCs int64
+}
+
+// Stats returns the VM's counters. It is safe to call from any goroutine
+func (vm *VM) Stats() Stats {
+	return Stats{
+		Interprets:   atomic.LoadInt64(&vm.stats.Interprets),
+		Calls:        atomic.LoadInt64(&vm.stats.Calls),
+		ForeignCalls: atomic.LoadInt64(&vm.stats.ForeignCalls),
+		Aborts:       atomic.LoadInt64(&vm.stats.Aborts),
+		GCs:          atomic.LoadInt64(&vm.stats.GCs),
+	}
+}
+
+// count increments one of the VM's counters
+func count(counter *int64) {
+	atomic.AddInt64(counter, 1)
+}
+
+// observeMethod wraps a foreign method so that it is counted and timed for the config's `MethodObserver`. If there is no observer, it is only counted
+func (vm *VM) observeMethod(module, class, signature string, fn ForeignMethodFn) ForeignMethodFn {
+	observer := vm.Config.MethodObserver
+	if observer == nil {
+		return func(vm *VM, parameters []interface{}) (interface{}, error) {
+			count(&vm.stats.ForeignCalls)
+			return fn(vm, parameters)
+		}
+	}
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		count(&vm.stats.ForeignCalls)
+		start := time.Now()
+		ret, err := fn(vm, parameters)
+		observer(vm, MethodCall{Module: module, Class: class, Signature: signature, Duration: time.Since(start), Err: err})
+		return ret, err
+	}
+}
+
+// instrument wraps a foreign method for the config's `AuditSink` and `MethodObserver`
+func (vm *VM) instrument(module, class, signature string, fn ForeignMethodFn) ForeignMethodFn {
+	return vm.observeMethod(module, class, signature, vm.auditMethod(module, class, signature, fn))
+}
@@ -0,0 +1,355 @@
+package wren
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// MsgpackError is returned from `DecodeMsgpack` if the data isn't valid MessagePack or uses a type Wren has no value for (such as extension types)
+type MsgpackError struct {
+	Offset int
+	Reason string
+}
+
+func (err *MsgpackError) Error() string {
+	return fmt.Sprintf("Invalid MessagePack at byte %v: %v", err.Offset, err.Reason)
+}
+
+// EncodeMsgpack serializes a Wren value into MessagePack. `value` can be null, a bool, number, string, or a list or map handle containing those (Go slices and maps of them work as well). Numbers without a fraction are encoded as integers and strings as MessagePack strings. Like `MapHandle.Keys`, maps can't be encoded while the VM is running. A list or map that contains itself returns `CyclicValue`. Other values return `InvalidValue`
+func EncodeMsgpack(value interface{}) ([]byte, error) {
+	var encoder msgpackEncoder
+	if err := encoder.encode(value); err != nil {
+		return nil, err
+	}
+	return encoder.data, nil
+}
+
+// DecodeMsgpack deserializes MessagePack into a Wren value for `vm`. Arrays become lists, maps become maps, binary data and strings become strings, and every number becomes a float64. Arrays and maps nested more than `MsgpackMaxDepth` deep return `MsgpackError`. Lists and maps are returned as handles that should be freed
+func DecodeMsgpack(vm *VM, data []byte) (interface{}, error) {
+	decoder := msgpackDecoder{data: data}
+	value, err := decoder.decode()
+	if err != nil {
+		return nil, err
+	}
+	if decoder.offset != len(data) {
+		return nil, &MsgpackError{Offset: decoder.offset, Reason: "unexpected data after the value"}
+	}
+	return vm.Marshal(value)
+}
+
+// MsgpackMaxDepth is how deeply `DecodeMsgpack` lets arrays and maps be nested, so untrusted data can't use up the stack
+const MsgpackMaxDepth = 512
+
+type msgpackEncoder struct {
+	data []byte
+	// the lists and maps being encoded, to find ones that contain themselves
+	parents []*Handle
+}
+
+// enter records that `handle` is being encoded and returns `CyclicValue` if it already was
+func (e *msgpackEncoder) enter(handle *Handle) error {
+	for _, parent := range e.parents {
+		if parent.Same(handle) {
+			return &CyclicValue{}
+		}
+	}
+	e.parents = append(e.parents, handle)
+	return nil
+}
+
+func (e *msgpackEncoder) leave() {
+	e.parents = e.parents[:len(e.parents)-1]
+}
+
+// uint appends the last `size` bytes of `value` in big endian order
+func (e *msgpackEncoder) uint(value uint64, size int) {
+	for shift := (size - 1) * 8; shift >= 0; shift -= 8 {
+		e.data = append(e.data, byte(value>>shift))
+	}
+}
+
+func (e *msgpackEncoder) header(fixed byte, fixedMax int, sized [3]byte, length int) {
+	switch {
+	case length <= fixedMax:
+		e.data = append(e.data, fixed|byte(length))
+	case sized[0] != 0 && length <= math.MaxUint8:
+		e.data = append(e.data, sized[0], byte(length))
+	case length <= math.MaxUint16:
+		e.data = append(e.data, sized[1])
+		e.uint(uint64(length), 2)
+	default:
+		e.data = append(e.data, sized[2])
+		e.uint(uint64(length), 4)
+	}
+}
+
+func (e *msgpackEncoder) number(value float64) {
+	switch {
+	case value != math.Trunc(value) || math.IsInf(value, 0) || value < math.MinInt64 || value >= math.MaxInt64:
+		e.data = append(e.data, 0xcb)
+		e.uint(math.Float64bits(value), 8)
+	case value >= 0 && value <= 0x7f:
+		e.data = append(e.data, byte(value))
+	case value < 0 && value >= -32:
+		e.data = append(e.data, byte(int8(value)))
+	case value >= math.MinInt32 && value <= math.MaxInt32:
+		e.data = append(e.data, 0xd2)
+		e.uint(uint64(uint32(int32(value))), 4)
+	default:
+		e.data = append(e.data, 0xd3)
+		e.uint(uint64(int64(value)), 8)
+	}
+}
+
+func (e *msgpackEncoder) encode(value interface{}) error {
+	switch value := value.(type) {
+	case nil, nullValue:
+		e.data = append(e.data, 0xc0)
+	case bool:
+		if value {
+			e.data = append(e.data, 0xc3)
+		} else {
+			e.data = append(e.data, 0xc2)
+		}
+	case float64:
+		e.number(value)
+	case int:
+		e.number(float64(value))
+	case string:
+		e.header(0xa0, 31, [3]byte{0xd9, 0xda, 0xdb}, len(value))
+		e.data = append(e.data, value...)
+	case []interface{}:
+		e.header(0x90, 15, [3]byte{0, 0xdc, 0xdd}, len(value))
+		for _, element := range value {
+			if err := e.encode(element); err != nil {
+				return err
+			}
+		}
+	case map[interface{}]interface{}:
+		e.header(0x80, 15, [3]byte{0, 0xde, 0xdf}, len(value))
+		for key, element := range value {
+			if err := e.encode(key); err != nil {
+				return err
+			}
+			if err := e.encode(element); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		e.header(0x80, 15, [3]byte{0, 0xde, 0xdf}, len(value))
+		for key, element := range value {
+			if err := e.encode(key); err != nil {
+				return err
+			}
+			if err := e.encode(element); err != nil {
+				return err
+			}
+		}
+	case *ListHandle:
+		if err := e.enter(value.handle); err != nil {
+			return err
+		}
+		defer e.leave()
+		elements, err := value.ToSlice(false)
+		if err != nil {
+			return err
+		}
+		defer value.VM().FreeAll(elements...)
+		return e.encode(elements)
+	case *MapHandle:
+		if err := e.enter(value.handle); err != nil {
+			return err
+		}
+		defer e.leave()
+		count, err := value.Count()
+		if err != nil {
+			return err
+		}
+		e.header(0x80, 15, [3]byte{0, 0xde, 0xdf}, count)
+		return value.ForEach(func(key, element interface{}) error {
+			if err := e.encode(key); err != nil {
+				return err
+			}
+			return e.encode(element)
+		})
+	default:
+		return &InvalidValue{Value: value}
+	}
+	return nil
+}
+
+// msgpackNumberSizes is how many bytes follow each type of number
+var msgpackNumberSizes = map[byte]int{0xcc: 1, 0xcd: 2, 0xce: 4, 0xcf: 8, 0xd0: 1, 0xd1: 2, 0xd2: 4, 0xd3: 8, 0xca: 4, 0xcb: 8}
+
+type msgpackDecoder struct {
+	data   []byte
+	offset int
+	// how many arrays and maps the value being decoded is in
+	depth int
+}
+
+// nest records that an array or map is being decoded and returns an error if they are nested too deeply
+func (d *msgpackDecoder) nest() error {
+	if d.depth >= MsgpackMaxDepth {
+		return &MsgpackError{Offset: d.offset, Reason: fmt.Sprintf("arrays and maps are nested more than %v deep", MsgpackMaxDepth)}
+	}
+	d.depth++
+	return nil
+}
+
+func (d *msgpackDecoder) read(n int) ([]byte, error) {
+	if n < 0 || len(d.data)-d.offset < n {
+		return nil, &MsgpackError{Offset: d.offset, Reason: "unexpected end of data"}
+	}
+	data := d.data[d.offset : d.offset+n]
+	d.offset += n
+	return data, nil
+}
+
+// length reads a big endian length of `size` bytes
+func (d *msgpackDecoder) length(size int) (int, error) {
+	data, err := d.read(size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 1:
+		return int(data[0]), nil
+	case 2:
+		return int(binary.BigEndian.Uint16(data)), nil
+	}
+	return int(binary.BigEndian.Uint32(data)), nil
+}
+
+func (d *msgpackDecoder) str(size int) (interface{}, error) {
+	length, err := d.length(size)
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.read(length)
+	return string(data), err
+}
+
+func (d *msgpackDecoder) array(length int) (interface{}, error) {
+	if length > len(d.data)-d.offset {
+		return nil, &MsgpackError{Offset: d.offset, Reason: "array is longer than the data"}
+	}
+	if err := d.nest(); err != nil {
+		return nil, err
+	}
+	defer func() { d.depth-- }()
+	values := make([]interface{}, length)
+	for i := range values {
+		value, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func (d *msgpackDecoder) dict(length int) (interface{}, error) {
+	if length > len(d.data)-d.offset {
+		return nil, &MsgpackError{Offset: d.offset, Reason: "map is longer than the data"}
+	}
+	if err := d.nest(); err != nil {
+		return nil, err
+	}
+	defer func() { d.depth-- }()
+	values := make(map[interface{}]interface{}, length)
+	for i := 0; i < length; i++ {
+		start := d.offset
+		key, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		switch key.(type) {
+		case nil, bool, float64, string:
+		default:
+			return nil, &MsgpackError{Offset: start, Reason: "map keys must be null, bools, numbers or strings"}
+		}
+		value, err := d.decode()
+		if err != nil {
+			return nil, err
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+func (d *msgpackDecoder) decode() (interface{}, error) {
+	start := d.offset
+	data, err := d.read(1)
+	if err != nil {
+		return nil, err
+	}
+	b := data[0]
+	switch {
+	case b <= 0x7f:
+		return float64(b), nil
+	case b >= 0xe0:
+		return float64(int8(b)), nil
+	case b&0xf0 == 0x80:
+		return d.dict(int(b & 0x0f))
+	case b&0xf0 == 0x90:
+		return d.array(int(b & 0x0f))
+	case b&0xe0 == 0xa0:
+		data, err := d.read(int(b & 0x1f))
+		return string(data), err
+	}
+	if size, ok := msgpackNumberSizes[b]; ok {
+		data, err := d.read(size)
+		if err != nil {
+			return nil, err
+		}
+		var bits uint64
+		for _, digit := range data {
+			bits = bits<<8 | uint64(digit)
+		}
+		switch b {
+		case 0xca:
+			return float64(math.Float32frombits(uint32(bits))), nil
+		case 0xcb:
+			return math.Float64frombits(bits), nil
+		case 0xd0:
+			return float64(int8(bits)), nil
+		case 0xd1:
+			return float64(int16(bits)), nil
+		case 0xd2:
+			return float64(int32(bits)), nil
+		case 0xd3:
+			return float64(int64(bits)), nil
+		}
+		return float64(bits), nil
+	}
+	switch b {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xd9:
+		return d.str(1)
+	case 0xc5, 0xda:
+		return d.str(2)
+	case 0xc6, 0xdb:
+		return d.str(4)
+	case 0xdc, 0xdd, 0xde, 0xdf:
+		size := 2
+		if b == 0xdd || b == 0xdf {
+			size = 4
+		}
+		length, err := d.length(size)
+		if err != nil {
+			return nil, err
+		}
+		if b == 0xdc || b == 0xdd {
+			return d.array(length)
+		}
+		return d.dict(length)
+	}
+	return nil, &MsgpackError{Offset: start, Reason: fmt.Sprintf("unsupported type 0x%02x", b)}
+}
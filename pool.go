@@ -0,0 +1,149 @@
+package wren
+
+import (
+	"context"
+	"sync"
+)
+
+// Pool owns a fixed number of VMs created from the same config so that scripts can run concurrently without creating a VM for every job. Each job gets a VM to itself and the VM is reset when it is returned to the pool, so jobs don't see what earlier jobs left behind
+type Pool struct {
+	vms   chan *VM
+	cfg   *Config
+	setup func(vm *VM) error
+	mux   sync.Mutex
+	freed bool
+}
+
+// PoolClosed is returned from a `Pool` that has been freed
+type PoolClosed struct{}
+
+func (err *PoolClosed) Error() string {
+	return "Pool has been freed"
+}
+
+// NewPool creates `size` VMs from `cfg`. If `setup` is not nil, it is called on every VM once it is created, such as to set modules or interpret shared code. If `setup` fails, the VMs created so far are freed and its error is returned
+func NewPool(cfg *Config, size int, setup func(vm *VM) error) (*Pool, error) {
+	if cfg == nil {
+		cfg = NewConfig()
+	}
+	pool := &Pool{vms: make(chan *VM, size), cfg: cfg, setup: setup}
+	for i := 0; i < size; i++ {
+		vm := cfg.NewVM()
+		if err := pool.prepare(vm); err != nil {
+			vm.Free()
+			pool.Free()
+			return nil, err
+		}
+		pool.vms <- vm
+	}
+	return pool, nil
+}
+
+// prepare calls the pool's setup function on `vm`
+func (pool *Pool) prepare(vm *VM) error {
+	if pool.setup == nil {
+		return nil
+	}
+	return pool.setup(vm)
+}
+
+// renew resets `vm` and sets it up again for the next job. If that fails, `vm` is freed and a new VM is created in its place. If that fails too, nil is returned and the pool has one VM less
+func (pool *Pool) renew(vm *VM) *VM {
+	if err := vm.Reset(); err == nil {
+		if err := pool.prepare(vm); err == nil {
+			return vm
+		}
+	}
+	vm.Free()
+	vm = pool.cfg.NewVM()
+	if err := pool.prepare(vm); err != nil {
+		vm.Free()
+		return nil
+	}
+	return vm
+}
+
+// Get waits for a free VM and takes it out of the pool. It must be given back with `Put` when it is no longer in use. If `ctx` is done first, its error is returned
+func (pool *Pool) Get(ctx context.Context) (*VM, error) {
+	select {
+	case vm, ok := <-pool.vms:
+		if !ok {
+			return nil, &PoolClosed{}
+		}
+		return vm, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Put gives a VM taken with `Get` back to the pool. The VM is reset and the pool's setup function is called on it again, so handles from it can't be used afterwards. If the VM can't be reset or set up, it is replaced with a new one
+func (pool *Pool) Put(vm *VM) {
+	pool.mux.Lock()
+	freed := pool.freed
+	pool.mux.Unlock()
+	if !freed {
+		vm = pool.renew(vm)
+	}
+	pool.mux.Lock()
+	defer pool.mux.Unlock()
+	if pool.freed {
+		if vm != nil {
+			vm.Free()
+		}
+		return
+	}
+	if vm != nil {
+		pool.vms <- vm
+	}
+}
+
+// Do calls `fn` with a free VM and returns it to the pool afterwards
+func (pool *Pool) Do(ctx context.Context, fn func(vm *VM) error) error {
+	vm, err := pool.Get(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Put(vm)
+	return fn(vm)
+}
+
+// InterpretString interprets `source` as `module` on a free VM. The script runs until it finishes or `ctx` is done (see `VM.InterpretStringContext`)
+func (pool *Pool) InterpretString(ctx context.Context, module, source string) error {
+	return pool.Do(ctx, func(vm *VM) error {
+		return vm.InterpretStringContext(ctx, module, source)
+	})
+}
+
+// Call calls `signature` on the variable `variable` from `module` on a free VM (see `VM.Call`). Since the VM goes back to the pool, lists and maps are returned as Go slices and maps and any other object is freed and returned as `UnexpectedValue`
+func (pool *Pool) Call(ctx context.Context, module, variable, signature string, args ...interface{}) (result interface{}, err error) {
+	err = pool.Do(ctx, func(vm *VM) error {
+		value, err := vm.Call(module, variable, signature, args...)
+		if err != nil {
+			return err
+		}
+		if result, err = toGo(value); err != nil {
+			return err
+		}
+		if _, ok := result.(freeableHandle); ok {
+			vm.FreeAll(result)
+			result = nil
+			return &UnexpectedValue{Value: value}
+		}
+		return nil
+	})
+	return result, err
+}
+
+// Free frees every VM in the pool. VMs that are still in use are freed when they are given back
+func (pool *Pool) Free() {
+	pool.mux.Lock()
+	defer pool.mux.Unlock()
+	if pool.freed {
+		return
+	}
+	pool.freed = true
+	close(pool.vms)
+	for vm := range pool.vms {
+		vm.Free()
+	}
+}
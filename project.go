@@ -0,0 +1,293 @@
+package wren
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ManifestName is the file name `LoadProject` looks for when it is given a directory
+const ManifestName = "wren.mod"
+
+// Project describes a multi-file Wren project that is loaded from a manifest (see `LoadProject`)
+type Project struct {
+	// Directory that contains the manifest. `Roots` are relative to this directory
+	Dir string
+	// Name of the module that `Run` interprets
+	Entry string
+	// Directories that modules are loaded from, searched in order. If no roots are set, modules are loaded from `Dir`
+	Roots []string
+	// Maps the name used in an import to the module that should be loaded instead
+	Aliases map[string]string
+	// If not empty, only these modules (after aliases are applied) may be imported
+	Allow []string
+	// Modules that may never be imported
+	Deny []string
+	// Capabilities granted to the project's scripts
+	Grants []Capability
+	// Modules that are fetched from somewhere else by `Fetch`
+	Requires []Requirement
+	// Directory that fetched modules are stored in (relative to `Dir`). If it is not set, a "wrengo" directory in the user's cache directory is used
+	CacheDir string
+
+	// the lockfile, read when the first required module is loaded. VMs loading modules from other goroutines share it
+	lock    map[string]LockEntry
+	lockMux sync.Mutex
+}
+
+// ManifestError is returned from `LoadProject` if the manifest could not be parsed
+type ManifestError struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (err *ManifestError) Error() string {
+	return fmt.Sprintf("[%v line %v] %v", err.File, err.Line, err.Message)
+}
+
+// ModuleNotFound is returned if the file for a module could not be found
+type ModuleNotFound struct {
+	Module string
+}
+
+func (err *ModuleNotFound) Error() string {
+	return fmt.Sprintf("Could not find module \"%v\"", err.Module)
+}
+
+// ImportDenied is sent to `ErrorFn` if a script tries to import a module that it is not allowed to
+type ImportDenied struct {
+	Module string
+	// Why the import was denied, if there is more to it than the module not being allowed (see `SandboxPolicy`)
+	Reason error
+}
+
+func (err *ImportDenied) Error() string {
+	if err.Reason != nil {
+		return fmt.Sprintf("Importing module \"%v\" is not allowed: %v", err.Module, err.Reason)
+	}
+	return fmt.Sprintf("Importing module \"%v\" is not allowed", err.Module)
+}
+
+// Unwrap returns why the import was denied
+func (err *ImportDenied) Unwrap() error {
+	return err.Reason
+}
+
+// LoadProject reads a project manifest. `file` can either be the manifest itself or a directory containing a "wren.mod" file.
+//
+// Manifests have one directive per line and `//` starts a comment:
+//
+//	entry main                 // the module that Run interprets
+//	root src                   // directories modules are loaded from
+//	root lib
+//	alias json => vendor/json  // importing "json" loads "vendor/json" instead
+//	allow json                 // if any modules are allowed, only those may be imported
+//	deny os                    // modules that may never be imported
+//	grant io os                // capabilities granted to scripts
+//	require http https://example.com/http.wren         // modules fetched by Fetch
+//	require fmt git+https://example.com/fmt.git@v1.0.0#src/fmt.wren
+//	cache .wren_modules        // where fetched modules are stored
+func LoadProject(file string) (*Project, error) {
+	if info, err := os.Stat(file); err != nil {
+		return nil, err
+	} else if info.IsDir() {
+		file = filepath.Join(file, ManifestName)
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProject(file, data)
+}
+
+// ParseProject parses the manifest `data` as if it was read from `file`. See `LoadProject` for the manifest's syntax
+func ParseProject(file string, data []byte) (*Project, error) {
+	project := &Project{Dir: filepath.Dir(file), Aliases: make(map[string]string)}
+	lines := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; lines.Scan(); line++ {
+		fields := strings.Fields(lines.Text())
+		for i, field := range fields {
+			// "//" inside of a field (like a URL) doesn't start a comment
+			if strings.HasPrefix(field, "//") {
+				fields = fields[:i]
+				break
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		fail := func(format string, a ...interface{}) (*Project, error) {
+			return nil, &ManifestError{File: file, Line: line, Message: fmt.Sprintf(format, a...)}
+		}
+		switch directive, args := fields[0], fields[1:]; directive {
+		case "entry":
+			if len(args) != 1 {
+				return fail("entry expects a module name")
+			}
+			project.Entry = args[0]
+		case "root":
+			if len(args) != 1 {
+				return fail("root expects a directory")
+			}
+			project.Roots = append(project.Roots, args[0])
+		case "alias":
+			if len(args) != 3 || args[1] != "=>" {
+				return fail("alias expects \"alias <name> => <module>\"")
+			}
+			project.Aliases[args[0]] = args[2]
+		case "allow":
+			if len(args) == 0 {
+				return fail("allow expects at least one module")
+			}
+			project.Allow = append(project.Allow, args...)
+		case "deny":
+			if len(args) == 0 {
+				return fail("deny expects at least one module")
+			}
+			project.Deny = append(project.Deny, args...)
+		case "grant":
+			if len(args) == 0 {
+				return fail("grant expects at least one capability")
+			}
+			for _, capability := range args {
+				project.Grants = append(project.Grants, Capability(capability))
+			}
+		case "require":
+			if len(args) != 2 {
+				return fail("require expects \"require <module> <source>\"")
+			}
+			if _, ok := project.requirement(args[0]); ok {
+				return fail("module \"%v\" is already required", args[0])
+			}
+			project.Requires = append(project.Requires, Requirement{Module: args[0], Source: args[1]})
+		case "cache":
+			if len(args) != 1 {
+				return fail("cache expects a directory")
+			}
+			project.CacheDir = args[0]
+		default:
+			return fail("unknown directive \"%v\"", directive)
+		}
+	}
+	if err := lines.Err(); err != nil {
+		return nil, err
+	}
+	if project.Entry == "" {
+		return nil, &ManifestError{File: file, Message: "manifest does not declare an entry module"}
+	}
+	return project, nil
+}
+
+// ResolveModule applies the project's aliases to an imported module name and resolves relative names with `ResolveRelative`. It can be used as a `ResolveModuleFn`
+func (project *Project) ResolveModule(vm *VM, importer, name string) (string, bool) {
+	if alias, ok := project.Aliases[name]; ok {
+		return alias, true
+	}
+	return ResolveRelative(vm, importer, name)
+}
+
+// Allowed reports whether the project's sandbox settings allow importing `module`
+func (project *Project) Allowed(module string) bool {
+	for _, denied := range project.Deny {
+		if denied == module {
+			return false
+		}
+	}
+	if len(project.Allow) == 0 {
+		return true
+	}
+	for _, allowed := range project.Allow {
+		if allowed == module {
+			return true
+		}
+	}
+	return false
+}
+
+// FindModule returns the path of the file a module is loaded from by searching the project's roots. If the module name has no extension, ".wren" is added (or "/module.wren" for packages). Names that would leave a root like "../secret" are not found
+func (project *Project) FindModule(name string) (string, bool) {
+	roots := project.Roots
+	if len(roots) == 0 {
+		roots = []string{"."}
+	}
+	for _, root := range roots {
+		dir := filepath.Join(project.Dir, root)
+		for _, file := range moduleFiles(name) {
+			candidate := filepath.Join(dir, filepath.FromSlash(file))
+			if !insideDir(dir, candidate) {
+				continue
+			}
+			if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+				return candidate, true
+			}
+		}
+	}
+	return "", false
+}
+
+// insideDir returns whether the cleaned path `file` is `dir` or inside of it
+func insideDir(dir, file string) bool {
+	rel, err := filepath.Rel(dir, file)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// LoadModule loads a module from the project's roots (or the fetched modules in `Requires`), respecting the project's sandbox settings. It can be used as a `LoadModuleFn`
+func (project *Project) LoadModule(vm *VM, name string) (string, bool) {
+	if !project.Allowed(name) {
+		vm.sendError(&ImportDenied{Module: name})
+		return "", false
+	}
+	if _, ok := project.requirement(name); ok {
+		data, err := project.loadRequired(name)
+		if err != nil {
+			vm.sendError(err)
+			return "", false
+		}
+		return string(data), true
+	}
+	file, ok := project.FindModule(name)
+	if !ok {
+		return "", false
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", false
+	}
+	return string(data), true
+}
+
+// Configure returns a copy of `cfg` that resolves and loads modules from this project and grants the project's capabilities. If `cfg` is nil, `NewConfig` is used
+func (project *Project) Configure(cfg *Config) *Config {
+	if cfg == nil {
+		cfg = NewConfig()
+	}
+	cfg = cfg.Clone()
+	cfg.Capabilities = append(append([]Capability{}, cfg.Capabilities...), project.Grants...)
+	cfg.ResolveModuleFn = project.ResolveModule
+	cfg.LoadModuleFn = project.LoadModule
+	return cfg
+}
+
+// NewVM creates a VM that resolves and loads modules from this project
+func (project *Project) NewVM() *VM {
+	return project.Configure(nil).NewVM()
+}
+
+// Run interprets the project's entry module in `vm`
+func (project *Project) Run(vm *VM) error {
+	file, ok := project.FindModule(project.Entry)
+	if !ok {
+		return &ModuleNotFound{Module: project.Entry}
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	return vm.InterpretString(project.Entry, string(data))
+}
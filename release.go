@@ -0,0 +1,69 @@
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+*/
+import "C"
+import (
+	"runtime"
+	"sync"
+)
+
+// releaseQueue tracks handles created while `Config.AutoFreeHandles` is set. Go runs finalizers on their own goroutine while the VM isn't safe for concurrent use, so finalizers only queue their handle and the VM releases it the next time it creates a handle
+type releaseQueue struct {
+	// the ID of the handle holding each pointer
+	live    map[*C.WrenHandle]uint64
+	mux     sync.Mutex
+	pending []*Handle
+}
+
+// track registers a finalizer on `h` that queues its handle to be released once `h` is garbage collected
+func (vm *VM) track(h *Handle) {
+	queue := &vm.released
+	if queue.live == nil {
+		queue.live = make(map[*C.WrenHandle]uint64)
+	}
+	queue.live[h.handle] = h.id
+	runtime.SetFinalizer(h, queue.collected)
+}
+
+// collected is the finalizer of tracked handles. It queues `h` to be released by `releasePending`
+func (queue *releaseQueue) collected(h *Handle) {
+	queue.mux.Lock()
+	queue.pending = append(queue.pending, h)
+	queue.mux.Unlock()
+}
+
+// untrack stops `h` from being released automatically because it was freed manually
+func (vm *VM) untrack(h *Handle) {
+	if id, ok := vm.released.live[h.handle]; ok && id == h.id {
+		delete(vm.released.live, h.handle)
+		runtime.SetFinalizer(h, nil)
+	}
+}
+
+// releasePending releases the handles of every garbage collected `Handle`
+func (vm *VM) releasePending() {
+	queue := &vm.released
+	queue.mux.Lock()
+	pending := queue.pending
+	queue.pending = nil
+	queue.mux.Unlock()
+	for _, h := range pending {
+		// the pointer may belong to a newer handle if this one was freed already
+		if id, ok := queue.live[h.handle]; ok && id == h.id {
+			delete(queue.live, h.handle)
+			C.wrenReleaseHandle(vm.vm, h.handle)
+		}
+	}
+}
+
+// releaseAll releases every handle that is still waiting to be garbage collected, for when the VM is freed
+func (vm *VM) releaseAll() {
+	for handle := range vm.released.live {
+		C.wrenReleaseHandle(vm.vm, handle)
+	}
+	vm.released.live = nil
+}
@@ -0,0 +1,197 @@
+package wren
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+)
+
+// Reloader watches script files and interprets them again whenever they change so scripts can be edited while a game or tool is running. Files are polled for changes instead of using OS notifications, so the reloader works the same on every platform.
+//
+// A reloader is not safe for concurrent use. `Poll`, `Reload` and `Run` interpret scripts, so they should only be called from the goroutine that uses the VM
+type Reloader struct {
+	// Interval is how often `Run` checks files for changes. If it is zero, files are checked every 500 milliseconds
+	Interval time.Duration
+	// NewVM creates the VM a reload interprets scripts in. If it is nil, the current VM is reset with `VM.Reset` instead. If it is set and a reload fails, the VM from before the reload is kept so scripts keep running while the error is fixed. The previous VM is freed after a successful reload
+	NewVM func() *VM
+	// OnReload is called after every reload with the VM scripts are now running in, the files that changed and the error from interpreting them if there was one
+	OnReload func(vm *VM, changed []string, err error)
+
+	vm        *VM
+	entries   []reloadEntry
+	files     map[string]fileState
+	variables []*RetainedVariable
+}
+
+type reloadEntry struct {
+	module, file string
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// RetainedVariable is a module variable that a `Reloader` looks up again by name after every reload, so the value always comes from the scripts that are currently running
+type RetainedVariable struct {
+	Module, Name string
+	value        interface{}
+	err          error
+}
+
+// Value returns the variable's value from the last time it was looked up. Handles it returns are freed by the next reload
+func (v *RetainedVariable) Value() interface{} {
+	return v.value
+}
+
+// Err returns the error from the last time the variable was looked up, such as `NoSuchVariable` if a reload removed it
+func (v *RetainedVariable) Err() error {
+	return v.err
+}
+
+func (v *RetainedVariable) resolve(vm *VM) {
+	v.value, v.err = vm.GetVariable(v.Module, v.Name)
+}
+
+// NewReloader creates a reloader that runs scripts in `vm`
+func NewReloader(vm *VM) *Reloader {
+	return &Reloader{vm: vm, files: make(map[string]fileState)}
+}
+
+// VM returns the VM scripts are currently running in. This changes after each successful reload if `NewVM` is set
+func (r *Reloader) VM() *VM {
+	return r.vm
+}
+
+// Watch adds `file` as the source of `module`. Modules are interpreted by `Load` and every reload in the order they were added
+func (r *Reloader) Watch(module, file string) error {
+	if err := r.WatchFile(file); err != nil {
+		return err
+	}
+	r.entries = append(r.entries, reloadEntry{module: module, file: file})
+	return nil
+}
+
+// WatchFile makes changes to `file` trigger a reload without interpreting it directly. This is useful for files that watched modules import
+func (r *Reloader) WatchFile(file string) error {
+	state, err := statFile(file)
+	if err != nil {
+		return err
+	}
+	r.files[file] = state
+	return nil
+}
+
+// Retain looks up the variable `name` in `module` and keeps looking it up after every reload
+func (r *Reloader) Retain(module, name string) *RetainedVariable {
+	variable := &RetainedVariable{Module: module, Name: name}
+	variable.resolve(r.vm)
+	r.variables = append(r.variables, variable)
+	return variable
+}
+
+// Load interprets every watched module in the current VM
+func (r *Reloader) Load() error {
+	err := r.interpret(r.vm)
+	r.resolveAll()
+	return err
+}
+
+// Poll checks the watched files for changes and reloads if any of them did, returning whether a reload happened and the error from it
+func (r *Reloader) Poll() (bool, error) {
+	changed := r.changed()
+	if len(changed) == 0 {
+		return false, nil
+	}
+	return true, r.reload(changed)
+}
+
+// Reload interprets every watched module again even if none of them changed
+func (r *Reloader) Reload() error {
+	return r.reload(nil)
+}
+
+// Run polls the watched files every `Interval` until `ctx` is done. Errors from reloads are only passed to `OnReload`
+func (r *Reloader) Run(ctx context.Context) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = 500 * time.Millisecond
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			r.Poll()
+		}
+	}
+}
+
+func (r *Reloader) reload(changed []string) error {
+	var err error
+	if r.NewVM != nil {
+		vm := r.NewVM()
+		if err = r.interpret(vm); err != nil {
+			vm.Free()
+		} else {
+			r.vm.Free()
+			r.vm = vm
+			r.resolveAll()
+		}
+	} else {
+		if err = r.vm.Reset(); err == nil {
+			err = r.interpret(r.vm)
+		}
+		r.resolveAll()
+	}
+	if r.OnReload != nil {
+		r.OnReload(r.vm, changed, err)
+	}
+	return err
+}
+
+func (r *Reloader) interpret(vm *VM) error {
+	for _, entry := range r.entries {
+		data, err := ioutil.ReadFile(entry.file)
+		if err != nil {
+			return err
+		}
+		if err := vm.InterpretString(entry.module, string(data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reloader) resolveAll() {
+	for _, variable := range r.variables {
+		variable.resolve(r.vm)
+	}
+}
+
+// changed returns the watched files whose size or modification time changed since they were last checked
+func (r *Reloader) changed() []string {
+	var changed []string
+	for file, old := range r.files {
+		state, err := statFile(file)
+		if err != nil || (state.modTime.Equal(old.modTime) && state.size == old.size) {
+			continue
+		}
+		r.files[file] = state
+		changed = append(changed, file)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+func statFile(file string) (fileState, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return fileState{}, err
+	}
+	return fileState{modTime: info.ModTime(), size: info.Size()}, nil
+}
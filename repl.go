@@ -0,0 +1,144 @@
+package wren
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// REPL evaluates Wren source a line at a time in one module of a VM, so the variables and classes each line declares stay around for the next ones, like an interactive console. Lines that leave brackets, strings or block comments open are held until the input that finishes them arrives. Input that is a single expression has its result printed with `System.print` (unless it is null or the expression prints already, such as `System.print("hi")`)
+type REPL struct {
+	vm     *VM
+	module string
+	input  strings.Builder
+}
+
+// NewREPL creates a REPL that evaluates source in the module `module` of `vm`
+func NewREPL(vm *VM, module string) *REPL {
+	return &REPL{vm: vm, module: module}
+}
+
+// VM returns the VM the REPL evaluates source in
+func (r *REPL) VM() *VM {
+	return r.vm
+}
+
+// Module returns the name of the module the REPL evaluates source in
+func (r *REPL) Module() string {
+	return r.module
+}
+
+// Continuing returns whether the REPL is holding input that isn't finished yet, such as to show a different prompt
+func (r *REPL) Continuing() bool {
+	return r.input.Len() > 0
+}
+
+// Reset throws away input that isn't finished yet. The module keeps what earlier input declared
+func (r *REPL) Reset() {
+	r.input.Reset()
+}
+
+// Eval adds `line` to the REPL's input. If the input is finished, it is evaluated with `InterpretMore` and `done` is true. Otherwise it is held until more lines finish it and `done` is false. Blank lines with nothing held do nothing. Compile and runtime errors are still sent to `ErrorFn`. Afterwards the VM is polled once (see `Poll`) so fibers whose timers or tasks are already done can continue, but it doesn't wait for the ones that aren't
+func (r *REPL) Eval(line string) (done bool, err error) {
+	if r.input.Len() == 0 && strings.TrimSpace(line) == "" {
+		return true, nil
+	}
+	r.input.WriteString(line)
+	r.input.WriteByte('\n')
+	source := r.input.String()
+	if !finishedInput(source) {
+		return false, nil
+	}
+	r.input.Reset()
+	if isExpression(source) {
+		// The list keeps the expression on its first line (so errors point to
+		// the right lines) and lets it end with a line comment
+		source = "Fn.new {|value| value == null ? null : System.print(value) }.call([" + source + "][0])"
+	}
+	if err := r.vm.InterpretMore(r.module, source); err != nil {
+		return true, err
+	}
+	return true, r.vm.Poll()
+}
+
+// Run reads lines from `in` and evaluates them until `in` ends, writing a prompt to `out` before each line ("> ", or "... " while input isn't finished). Errors that aren't sent to `ErrorFn`, such as `VariableRedefined`, are written to `out` and don't stop the REPL. The error is only set if `in` couldn't be read
+func (r *REPL) Run(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	for {
+		if r.Continuing() {
+			fmt.Fprint(out, "... ")
+		} else {
+			fmt.Fprint(out, "> ")
+		}
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return scanner.Err()
+		}
+		_, err := r.Eval(scanner.Text())
+		var compileError *ResultCompileError
+		var runtimeError *ResultRuntimeError
+		if err != nil && !errors.As(err, &compileError) && !errors.As(err, &runtimeError) {
+			fmt.Fprintln(out, err)
+		}
+	}
+}
+
+// finishedInput returns whether `source` closes every bracket, string and block comment it opens
+func finishedInput(source string) bool {
+	s := scanner{source: source, line: 1}
+	s.scan(false)
+	if s.unterminated {
+		return false
+	}
+	depth := 0
+	for _, tok := range s.tokens {
+		if tok.kind != tokenPunct {
+			continue
+		}
+		switch tok.text {
+		case "{", "(", "[":
+			depth++
+		case "}", ")", "]":
+			depth--
+		}
+	}
+	// Too many closing brackets won't be fixed by more lines, so the compiler can report it
+	return depth <= 0
+}
+
+// statementKeywords start input that can't be an expression
+var statementKeywords = map[string]bool{
+	"var": true, "class": true, "foreign": true, "import": true, "if": true, "for": true,
+	"while": true, "return": true, "break": true, "continue": true,
+}
+
+// isExpression returns whether `source` is likely a single expression, so its result can be printed. Input that isn't one is run as it is
+func isExpression(source string) bool {
+	tokens := scanTokens(source)
+	for len(tokens) > 0 && tokens[len(tokens)-1].kind == tokenLine {
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 0 || tokens[0].kind == tokenName && statementKeywords[tokens[0].text] || tokens[0].kind == tokenPunct && tokens[0].text == "{" {
+		return false
+	}
+	if len(tokens) > 2 && tokens[0].text == "System" && tokens[1].text == "." && (strings.HasPrefix(tokens[2].text, "print") || tokens[2].text == "write") {
+		// these print what they return already
+		return false
+	}
+	depth := 0
+	for _, tok := range tokens {
+		switch {
+		case tok.kind == tokenLine && depth == 0:
+			// more than one statement
+			return false
+		case tok.kind != tokenPunct:
+		case tok.text == "{" || tok.text == "(" || tok.text == "[":
+			depth++
+		case tok.text == "}" || tok.text == ")" || tok.text == "]":
+			depth--
+		}
+	}
+	return true
+}
@@ -0,0 +1,71 @@
+package wren
+
+import (
+	"fmt"
+	"path"
+)
+
+// SandboxPolicy restricts what the scripts of a VM may import and which foreign classes they may construct. It is set as `Config.Sandbox`
+type SandboxPolicy struct {
+	// If set, only modules whose resolved names match one of these patterns can be imported. Patterns use the syntax of `path.Match`, so "lib/*" allows every module directly inside "lib"
+	AllowImports []string
+	// Modules whose resolved names match one of these patterns can't be imported, even if they are allowed by `AllowImports`
+	DenyImports []string
+	// The most modules scripts may import. Modules that were already imported and modules that were interpreted from Go don't count. 0 means there is no limit
+	MaxModules int
+	// If set, this is called for every import the patterns allow. Returning an error denies the import with it as the `ImportDenied.Reason`
+	ImportHook func(vm *VM, importer, name string) error
+	// If set, this is called whenever Wren binds a foreign class. Returning an error makes constructing the class abort with it
+	ForeignClassHook func(vm *VM, module, class string) error
+}
+
+// TooManyModules is the `ImportDenied.Reason` if scripts already imported `SandboxPolicy.MaxModules` modules
+type TooManyModules struct {
+	Max int
+}
+
+func (err *TooManyModules) Error() string {
+	return fmt.Sprintf("scripts may import at most %v modules", err.Max)
+}
+
+// matchAny returns whether `name` matches any of `patterns`
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// checkImport returns why importing `name` is denied by the sandbox, or nil if it is allowed. `loaded` is whether the module was imported already
+func (vm *VM) checkImport(importer, name string, loaded bool) error {
+	if vm.Config == nil || vm.Config.Sandbox == nil {
+		return nil
+	}
+	policy := vm.Config.Sandbox
+	var reason error
+	switch {
+	case len(policy.AllowImports) > 0 && !matchAny(policy.AllowImports, name), matchAny(policy.DenyImports, name):
+		return &ImportDenied{Module: name}
+	case !loaded && policy.MaxModules > 0 && vm.imported >= policy.MaxModules:
+		reason = &TooManyModules{Max: policy.MaxModules}
+	case policy.ImportHook != nil:
+		reason = policy.ImportHook(vm, importer, name)
+	}
+	if reason != nil {
+		return &ImportDenied{Module: name, Reason: reason}
+	}
+	if !loaded {
+		vm.imported++
+	}
+	return nil
+}
+
+// checkForeignClass returns the error `SandboxPolicy.ForeignClassHook` gives for the foreign class `class` in `module`
+func (vm *VM) checkForeignClass(module, class string) error {
+	if vm.Config == nil || vm.Config.Sandbox == nil || vm.Config.Sandbox.ForeignClassHook == nil {
+		return nil
+	}
+	return vm.Config.Sandbox.ForeignClassHook(vm, module, class)
+}
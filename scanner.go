@@ -0,0 +1,435 @@
+package wren
+
+import "strings"
+
+// A very small Wren tokenizer. It only knows enough about Wren's syntax to
+// find declarations and imports at the top of a module and to tell whether
+// brackets are balanced. It never needs to be as strict as Wren's compiler
+// because Wren will still compile the source afterwards.
+
+type tokenKind int
+
+const (
+	tokenName tokenKind = iota
+	tokenString
+	tokenNumber
+	tokenPunct
+	tokenLine
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+type scanner struct {
+	source string
+	pos    int
+	line   int
+	tokens []token
+	// unterminated is set if the source ended inside of a string or block comment
+	unterminated bool
+}
+
+func scanTokens(source string) []token {
+	s := scanner{source: source, line: 1}
+	s.scan(false)
+	return s.tokens
+}
+
+func (s *scanner) peek(offset int) byte {
+	if s.pos+offset < len(s.source) {
+		return s.source[s.pos+offset]
+	}
+	return 0
+}
+
+func (s *scanner) emit(kind tokenKind, text string, line int) {
+	s.tokens = append(s.tokens, token{kind: kind, text: text, line: line})
+}
+
+// scan reads tokens until the end of the source. If `interpolation` is true, it stops at the parenthesis that closes a string interpolation
+func (s *scanner) scan(interpolation bool) {
+	depth := 0
+	for s.pos < len(s.source) {
+		c := s.source[s.pos]
+		switch {
+		case c == '\n':
+			s.emit(tokenLine, "\n", s.line)
+			s.line++
+			s.pos++
+		case c == ' ' || c == '\t' || c == '\r':
+			s.pos++
+		case c == '/' && s.peek(1) == '/':
+			for s.pos < len(s.source) && s.source[s.pos] != '\n' {
+				s.pos++
+			}
+		case c == '/' && s.peek(1) == '*':
+			s.skipBlockComment()
+		case c == '"':
+			s.scanString()
+		case isNameStart(c):
+			start := s.pos
+			for s.pos < len(s.source) && isNameChar(s.source[s.pos]) {
+				s.pos++
+			}
+			s.emit(tokenName, s.source[start:s.pos], s.line)
+		case c >= '0' && c <= '9':
+			start := s.pos
+			for s.pos < len(s.source) && (isNameChar(s.source[s.pos]) || s.source[s.pos] == '.' && s.peek(1) >= '0' && s.peek(1) <= '9') {
+				s.pos++
+			}
+			s.emit(tokenNumber, s.source[start:s.pos], s.line)
+		default:
+			if interpolation {
+				if c == '(' {
+					depth++
+				} else if c == ')' {
+					if depth == 0 {
+						s.pos++
+						return
+					}
+					depth--
+				}
+			}
+			s.emit(tokenPunct, string(c), s.line)
+			s.pos++
+		}
+	}
+	if interpolation {
+		s.unterminated = true
+	}
+}
+
+func (s *scanner) skipBlockComment() {
+	nesting := 0
+	for s.pos < len(s.source) {
+		switch {
+		case s.source[s.pos] == '/' && s.peek(1) == '*':
+			nesting++
+			s.pos += 2
+		case s.source[s.pos] == '*' && s.peek(1) == '/':
+			nesting--
+			s.pos += 2
+			if nesting == 0 {
+				return
+			}
+		default:
+			if s.source[s.pos] == '\n' {
+				s.line++
+			}
+			s.pos++
+		}
+	}
+	s.unterminated = true
+}
+
+func (s *scanner) scanString() {
+	line := s.line
+	if strings.HasPrefix(s.source[s.pos:], `"""`) {
+		s.pos += 3
+		end := strings.Index(s.source[s.pos:], `"""`)
+		if end < 0 {
+			s.unterminated = true
+			end = len(s.source) - s.pos
+		}
+		text := s.source[s.pos : s.pos+end]
+		s.line += strings.Count(text, "\n")
+		s.pos += end + 3
+		s.emit(tokenString, text, line)
+		return
+	}
+	s.pos++
+	var text strings.Builder
+	for s.pos < len(s.source) {
+		c := s.source[s.pos]
+		switch {
+		case c == '"':
+			s.pos++
+			s.emit(tokenString, text.String(), line)
+			return
+		case c == '\\' && s.pos+1 < len(s.source):
+			text.WriteString(unescape(s.source[s.pos+1]))
+			s.pos += 2
+		case c == '%' && s.peek(1) == '(':
+			// The interpolated expression is scanned as code but its tokens are
+			// nested inside of this string so they are thrown away
+			s.pos += 2
+			nested := scanner{source: s.source, pos: s.pos, line: s.line}
+			nested.scan(true)
+			s.pos, s.line = nested.pos, nested.line
+			if nested.unterminated {
+				s.unterminated = true
+			}
+		default:
+			if c == '\n' {
+				s.line++
+			}
+			text.WriteByte(c)
+			s.pos++
+		}
+	}
+	s.unterminated = true
+	s.emit(tokenString, text.String(), line)
+}
+
+func unescape(c byte) string {
+	switch c {
+	case '0':
+		return "\x00"
+	case 'a':
+		return "\a"
+	case 'b':
+		return "\b"
+	case 'e':
+		return "\x1b"
+	case 'f':
+		return "\f"
+	case 'n':
+		return "\n"
+	case 'r':
+		return "\r"
+	case 't':
+		return "\t"
+	case 'v':
+		return "\v"
+	default:
+		return string(c)
+	}
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || c >= '0' && c <= '9'
+}
+
+// declaration is a module level variable declared by Wren source
+type declaration struct {
+	name string
+	line int
+	// for `import ... for`, the name of the variable in the imported module (which differs from `name` if it was renamed with `as`)
+	from string
+}
+
+// topLevelDeclarations finds the module variables that `source` declares with `var`, `class`, or `import ... for`
+func topLevelDeclarations(source string) []declaration {
+	tokens := scanTokens(source)
+	var decls []declaration
+	depth := 0
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.kind == tokenPunct {
+			switch tok.text {
+			case "{", "(", "[":
+				depth++
+			case "}", ")", "]":
+				depth--
+			}
+			continue
+		}
+		if depth != 0 || tok.kind != tokenName {
+			continue
+		}
+		switch tok.text {
+		case "var", "class":
+			if i+1 < len(tokens) && tokens[i+1].kind == tokenName {
+				decls = append(decls, declaration{name: tokens[i+1].text, line: tokens[i+1].line})
+				i++
+			}
+		case "import":
+			_, names, next := parseImport(tokens, i)
+			decls = append(decls, names...)
+			i = next - 1
+		}
+	}
+	return decls
+}
+
+// parseImport reads an import statement starting at tokens[start] (the "import" keyword). It returns the module name, the variables it declares and the index of the token after the statement
+func parseImport(tokens []token, start int) (module string, names []declaration, next int) {
+	i := start + 1
+	if i >= len(tokens) || tokens[i].kind != tokenString {
+		return "", nil, i
+	}
+	module = tokens[i].text
+	i++
+	if i >= len(tokens) || tokens[i].kind != tokenName || tokens[i].text != "for" {
+		return module, nil, i
+	}
+	i++
+	for i < len(tokens) {
+		// import variable lists may continue onto the next line after a comma
+		for i < len(tokens) && tokens[i].kind == tokenLine {
+			i++
+		}
+		if i >= len(tokens) || tokens[i].kind != tokenName {
+			break
+		}
+		decl := declaration{name: tokens[i].text, line: tokens[i].line, from: tokens[i].text}
+		i++
+		if i+1 < len(tokens) && tokens[i].kind == tokenName && tokens[i].text == "as" && tokens[i+1].kind == tokenName {
+			decl = declaration{name: tokens[i+1].text, line: tokens[i+1].line, from: decl.from}
+			i += 2
+		}
+		names = append(names, decl)
+		if i >= len(tokens) || tokens[i].kind != tokenPunct || tokens[i].text != "," {
+			break
+		}
+		i++
+	}
+	return module, names, i
+}
+
+// ClassDeclaration is a class declared in Wren source that is foreign or has foreign methods
+type ClassDeclaration struct {
+	Name    string
+	Foreign bool
+	// Signatures of the class's constructors, such as "new(_,_)"
+	Constructors []string
+	// Signatures of the class's foreign methods the way they are written in a `MethodMap`, such as "static bar(_)"
+	Methods []string
+}
+
+// ParseDeclarations finds the classes in `source` that are foreign or declare foreign methods, in the order they are declared. Like the rest of WrenGo's tokenizer, it doesn't check that the source compiles
+func ParseDeclarations(source string) []ClassDeclaration {
+	tokens := scanTokens(source)
+	var classes []ClassDeclaration
+	depth := 0
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.kind == tokenPunct {
+			switch tok.text {
+			case "{", "(", "[":
+				depth++
+			case "}", ")", "]":
+				depth--
+			}
+			continue
+		}
+		if depth != 0 || tok.kind != tokenName || tok.text != "class" || i+1 >= len(tokens) || tokens[i+1].kind != tokenName {
+			continue
+		}
+		class := ClassDeclaration{Name: tokens[i+1].text, Foreign: i > 0 && tokens[i-1].kind == tokenName && tokens[i-1].text == "foreign"}
+		// skip to the class body, past any superclass
+		i += 2
+		for i < len(tokens) && !(tokens[i].kind == tokenPunct && tokens[i].text == "{") {
+			i++
+		}
+		i = parseClassBody(tokens, i+1, &class)
+		if class.Foreign || len(class.Methods) > 0 {
+			classes = append(classes, class)
+		}
+	}
+	return classes
+}
+
+// parseClassBody reads the members of a class starting after its "{", adding its constructors and foreign methods to `class`. It returns the index of the "}" that ends the class
+func parseClassBody(tokens []token, i int, class *ClassDeclaration) int {
+	depth := 0
+	memberStart := true
+	for ; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.kind == tokenLine {
+			memberStart = depth == 0
+			continue
+		}
+		start := memberStart && depth == 0
+		memberStart = false
+		if tok.kind == tokenPunct {
+			switch tok.text {
+			case "{", "(", "[":
+				depth++
+			case "}", ")", "]":
+				if depth == 0 {
+					return i
+				}
+				depth--
+			}
+			continue
+		}
+		if !start || tok.kind != tokenName {
+			continue
+		}
+		switch tok.text {
+		case "foreign":
+			if signature, next := parseSignatureTokens(tokens, i+1); signature != "" {
+				class.Methods = append(class.Methods, signature)
+				i = next - 1
+			}
+		case "construct":
+			if signature, next := parseSignatureTokens(tokens, i+1); signature != "" {
+				class.Constructors = append(class.Constructors, signature)
+				i = next - 1
+			}
+		}
+	}
+	return i
+}
+
+// parseSignatureTokens reads a method declaration (after "foreign" or "construct") and returns its signature and the index of the token after it. An empty signature is returned if it isn't one `ParseSignature` accepts
+func parseSignatureTokens(tokens []token, i int) (string, int) {
+	var signature strings.Builder
+	if i < len(tokens) && tokens[i].kind == tokenName && tokens[i].text == "static" {
+		signature.WriteString("static ")
+		i++
+	}
+	// parameters reads a list of parameter names up to `end`, writing an underscore for each
+	parameters := func(end string) bool {
+		for ; i < len(tokens); i++ {
+			tok := tokens[i]
+			switch {
+			case tok.kind == tokenPunct && tok.text == end:
+				i++
+				return true
+			case tok.kind == tokenPunct && tok.text == ",":
+				signature.WriteString(",")
+			case tok.kind == tokenName:
+				signature.WriteString("_")
+			case tok.kind != tokenLine:
+				return false
+			}
+		}
+		return false
+	}
+	punct := func(text string) bool {
+		return i < len(tokens) && tokens[i].kind == tokenPunct && tokens[i].text == text
+	}
+	switch {
+	case punct("["):
+		signature.WriteString("[")
+		i++
+		if !parameters("]") {
+			return "", i
+		}
+		signature.WriteString("]")
+	case i < len(tokens) && tokens[i].kind == tokenName:
+		signature.WriteString(tokens[i].text)
+		i++
+	default:
+		// operators are split into a token for every character
+		for i < len(tokens) && tokens[i].kind == tokenPunct && !punct("(") && !punct("{") {
+			signature.WriteString(tokens[i].text)
+			i++
+		}
+	}
+	if punct("=") && i+1 < len(tokens) && tokens[i+1].kind == tokenPunct && tokens[i+1].text == "(" {
+		signature.WriteString("=")
+		i++
+	}
+	if punct("(") {
+		signature.WriteString("(")
+		i++
+		if !parameters(")") {
+			return "", i
+		}
+		signature.WriteString(")")
+	}
+	if _, err := ParseSignature(signature.String()); err != nil {
+		return "", i
+	}
+	return signature.String(), i
+}
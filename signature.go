@@ -0,0 +1,269 @@
+package wren
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxParameters is the most parameters a Wren method can have
+const maxParameters = 16
+
+// SignatureKind is the kind of method a signature is for
+type SignatureKind int
+
+const (
+	// A method called with parentheses, such as "foo(_,_)"
+	SignatureMethod SignatureKind = iota
+	// A method called without parentheses, such as "foo"
+	SignatureGetter
+	// A method called by assigning to it, such as "foo=(_)"
+	SignatureSetter
+	// A subscript, such as "[_,_]"
+	SignatureSubscript
+	// A subscript that is assigned to, such as "[_]=(_)"
+	SignatureSubscriptSetter
+	// A prefix operator, such as "-" or "!"
+	SignaturePrefixOperator
+	// An infix operator, such as "+(_)" or "==(_)"
+	SignatureInfixOperator
+)
+
+var (
+	prefixOperators = map[string]bool{"-": true, "!": true, "~": true}
+	infixOperators  = map[string]bool{
+		"+": true, "-": true, "*": true, "/": true, "%": true,
+		"<": true, ">": true, "<=": true, ">=": true, "==": true, "!=": true,
+		"&": true, "|": true, "^": true, "<<": true, ">>": true,
+		"..": true, "...": true, "is": true,
+	}
+	reservedWords = map[string]bool{
+		"as": true, "break": true, "class": true, "construct": true, "continue": true, "else": true,
+		"false": true, "for": true, "foreign": true, "if": true, "import": true, "in": true, "is": true,
+		"null": true, "return": true, "static": true, "super": true, "this": true, "true": true,
+		"var": true, "while": true,
+	}
+)
+
+// Signature is a parsed method signature, such as a key of a `MethodMap`
+type Signature struct {
+	Static bool
+	Kind   SignatureKind
+	// The name of the method or the operator. Subscripts don't have a name
+	Name string
+	// How many parameters the method takes, not counting the value of setters
+	Arity int
+}
+
+// SignatureError is returned if a signature isn't one that Wren could bind
+type SignatureError struct {
+	Class, Signature, Reason string
+}
+
+func (err *SignatureError) Error() string {
+	if err.Class != "" {
+		return fmt.Sprintf("Invalid signature \"%v\" in class \"%v\": %v", err.Signature, err.Class, err.Reason)
+	}
+	return fmt.Sprintf("Invalid signature \"%v\": %v", err.Signature, err.Reason)
+}
+
+// ParseSignature parses a signature the way it is written in a `MethodMap`, returning `SignatureError` if Wren could never bind a method to it
+func ParseSignature(signature string) (*Signature, error) {
+	fail := func(reason string) (*Signature, error) {
+		return nil, &SignatureError{Signature: signature, Reason: reason}
+	}
+	sig := &Signature{}
+	s := signature
+	if strings.HasPrefix(s, "static ") {
+		sig.Static = true
+		s = s[len("static "):]
+	}
+	switch {
+	case s == "":
+		return fail("it is empty")
+	case s[0] == '[':
+		end := strings.IndexByte(s, ']')
+		if end < 0 {
+			return fail("the subscript is missing its \"]\"")
+		}
+		arity, err := parseParameters(s[1:end])
+		if err != "" {
+			return fail(err)
+		}
+		if arity == 0 {
+			return fail("subscripts take at least one parameter")
+		}
+		sig.Arity = arity
+		switch s[end+1:] {
+		case "":
+			sig.Kind = SignatureSubscript
+		case "=(_)":
+			sig.Kind = SignatureSubscriptSetter
+		default:
+			return fail("only \"=(_)\" can follow a subscript")
+		}
+		return sig, nil
+	case prefixOperators[s]:
+		sig.Kind, sig.Name = SignaturePrefixOperator, s
+		return sig, nil
+	case strings.HasSuffix(s, "(_)") && infixOperators[strings.TrimSuffix(s, "(_)")]:
+		sig.Kind, sig.Name, sig.Arity = SignatureInfixOperator, strings.TrimSuffix(s, "(_)"), 1
+		return sig, nil
+	}
+	name := s[:identifierLength(s)]
+	if name == "" {
+		return fail("it must start with a name, an operator, or \"[\"")
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return fail("names can't start with a digit")
+	}
+	if reservedWords[name] {
+		return fail(fmt.Sprintf("\"%v\" is a reserved word", name))
+	}
+	sig.Name = name
+	switch rest := s[len(name):]; {
+	case rest == "":
+		sig.Kind = SignatureGetter
+	case rest == "=(_)":
+		sig.Kind, sig.Arity = SignatureSetter, 1
+	case strings.HasPrefix(rest, "=("):
+		return fail("setters take exactly one parameter")
+	case strings.HasPrefix(rest, "(") && strings.HasSuffix(rest, ")"):
+		arity, err := parseParameters(rest[1 : len(rest)-1])
+		if err != "" {
+			return fail(err)
+		}
+		sig.Kind, sig.Arity = SignatureMethod, arity
+	default:
+		return fail(fmt.Sprintf("unexpected \"%v\" after the name", rest))
+	}
+	return sig, nil
+}
+
+// String returns the signature the way Wren writes it when binding, with "static " in front if it is static
+func (sig *Signature) String() string {
+	var s string
+	switch sig.Kind {
+	case SignatureMethod:
+		s = methodSignature(sig.Name, sig.Arity)
+	case SignatureGetter, SignaturePrefixOperator:
+		s = sig.Name
+	case SignatureSetter:
+		s = sig.Name + "=(_)"
+	case SignatureSubscript:
+		s = Subscript(sig.Arity)
+	case SignatureSubscriptSetter:
+		s = SubscriptSetter(sig.Arity)
+	case SignatureInfixOperator:
+		s = sig.Name + "(_)"
+	}
+	if sig.Static {
+		return Static(s)
+	}
+	return s
+}
+
+// Getter returns the signature of a getter, such as "name"
+func Getter(name string) string {
+	return name
+}
+
+// Setter returns the signature of a setter, such as "name=(_)"
+func Setter(name string) string {
+	return name + "=(_)"
+}
+
+// Subscript returns the signature of a subscript that takes `arity` parameters, such as "[_,_]"
+func Subscript(arity int) string {
+	return "[" + strings.TrimSuffix(strings.Repeat("_,", arity), ",") + "]"
+}
+
+// SubscriptSetter returns the signature of assigning to a subscript that takes `arity` parameters, such as "[_]=(_)"
+func SubscriptSetter(arity int) string {
+	return Subscript(arity) + "=(_)"
+}
+
+// Operator returns the signature of an operator. An `arity` of 0 is a prefix operator, such as "-", and 1 is an infix operator, such as "+(_)"
+func Operator(operator string, arity int) string {
+	if arity == 0 {
+		return operator
+	}
+	return operator + "(_)"
+}
+
+// Static returns the signature of the static version of a method, such as "static name"
+func Static(signature string) string {
+	return "static " + signature
+}
+
+// identifierLength returns how many bytes at the start of `s` could be part of a name
+func identifierLength(s string) int {
+	for i, c := range s {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// parseParameters counts the parameters in a list like "_,_,_", returning why it is malformed if it is
+func parseParameters(list string) (int, string) {
+	if list == "" {
+		return 0, ""
+	}
+	params := strings.Split(list, ",")
+	for _, param := range params {
+		if param != "_" {
+			return 0, "parameters must be underscores separated by commas without spaces"
+		}
+	}
+	if len(params) > maxParameters {
+		return 0, fmt.Sprintf("methods can't have more than %v parameters", maxParameters)
+	}
+	return len(params), ""
+}
+
+// InvalidSignatures is returned from `Module.Validate` with every signature in the module that Wren couldn't bind
+type InvalidSignatures struct {
+	Errors []*SignatureError
+}
+
+func (err *InvalidSignatures) Error() string {
+	messages := make([]string, len(err.Errors))
+	for i, e := range err.Errors {
+		messages[i] = e.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks that every signature in the module's `MethodMap`s is one Wren could bind, returning `InvalidSignatures` listing the ones that aren't. `SetModule` sends this error to `ErrorFn`, since Wren otherwise only fails once a script declares the method
+func (module *Module) Validate() error {
+	var errs []*SignatureError
+	classes := make([]string, 0, len(module.ClassMap))
+	for name := range module.ClassMap {
+		classes = append(classes, name)
+	}
+	sort.Strings(classes)
+	for _, name := range classes {
+		class := module.ClassMap[name]
+		if class == nil {
+			continue
+		}
+		signatures := make([]string, 0, len(class.MethodMap))
+		for signature := range class.MethodMap {
+			signatures = append(signatures, signature)
+		}
+		sort.Strings(signatures)
+		for _, signature := range signatures {
+			if _, err := ParseSignature(signature); err != nil {
+				sigErr := err.(*SignatureError)
+				sigErr.Class = name
+				errs = append(errs, sigErr)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return &InvalidSignatures{Errors: errs}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+//go:build go1.21
+
+package wren
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+)
+
+// slogAttrs returns the attributes that identify `vm` in log records
+func slogAttrs(vm *VM) []slog.Attr {
+	attrs := []slog.Attr{slog.Int64("vm_id", vm.ID())}
+	if name := vm.vmName(); name != "" {
+		attrs = append(attrs, slog.String("vm", name))
+	}
+	return attrs
+}
+
+// SlogWriteFn creates a `WriteFn` that logs text that scripts print to `logger` at the info level. Wren prints the newline after `System.print` separately, so trailing newlines are trimmed and writes that are only a newline are skipped
+func SlogWriteFn(logger *slog.Logger) WriteFn {
+	return func(vm *VM, text string) {
+		text = strings.TrimSuffix(text, "\n")
+		if text == "" {
+			return
+		}
+		logger.LogAttrs(context.Background(), slog.LevelInfo, text, slogAttrs(vm)...)
+	}
+}
+
+// SlogErrorFn creates an `ErrorFn` that logs errors to `logger`. Compile errors, runtime errors, and errors from Go are logged at the error level, while the stack trace that follows a runtime error is logged at the debug level. The module and line of the error are added as attributes when they are known
+func SlogErrorFn(logger *slog.Logger) ErrorFn {
+	return func(vm *VM, err error) {
+		level := slog.LevelError
+		message := err.Error()
+		attrs := slogAttrs(vm)
+		var (
+			compileErr *CompileError
+			stackTrace *StackTrace
+		)
+		switch {
+		case errors.As(err, &compileErr):
+			message = compileErr.Message()
+			attrs = append(attrs, slog.String("module", compileErr.Module()), slog.Int("line", compileErr.Line()))
+		case errors.As(err, &stackTrace):
+			level = slog.LevelDebug
+			message = "at " + stackTrace.message
+			attrs = append(attrs, slog.String("module", stackTrace.module), slog.Int("line", stackTrace.line))
+		}
+		logger.LogAttrs(context.Background(), level, message, attrs...)
+	}
+}
+
+// UseLogger sets the config's `WriteFn` and `ErrorFn` to log to `logger` (see `SlogWriteFn` and `SlogErrorFn`)
+func (cfg *Config) UseLogger(logger *slog.Logger) *Config {
+	cfg.WriteFn = SlogWriteFn(logger)
+	cfg.ErrorFn = SlogErrorFn(logger)
+	return cfg
+}
@@ -0,0 +1,41 @@
+//go:build go1.21
+
+package wren
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestSlog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	cfg := NewConfig().UseLogger(logger)
+	cfg.Name = "test"
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.InterpretString("main", `
+	System.print("hello")
+	Fiber.abort("broken")
+	`)
+	vm.InterpretString("broken", `}`)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	expected := []string{
+		`level=INFO msg=hello vm_id=%v vm=test`,
+		`level=ERROR msg=broken vm_id=%v vm=test`,
+		`level=DEBUG msg="at (script)" vm_id=%v vm=test module=main line=3`,
+		`level=ERROR msg="Error at '}': Expected expression." vm_id=%v vm=test module=broken line=1`,
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %v log records but got:\n%v", len(expected), buf.String())
+	}
+	for i, line := range lines {
+		want := fmt.Sprintf(expected[i], vm.ID())
+		if !strings.HasSuffix(line, want) {
+			t.Errorf("Expected record %q but got %q", want, line)
+		}
+	}
+}
@@ -0,0 +1,491 @@
+package wren
+
+/*
+#cgo CFLAGS:
+#cgo LDFLAGS: -lm
+#include "wren.h"
+
+// A value staged in Go that is written to (or read from) a slot. Setting and
+// getting many slots at once through these helpers only crosses from Go to C
+// once instead of once for every slot.
+typedef struct {
+	int type;
+	bool boolean;
+	double number;
+	// When setting, strings are stored in one buffer and this is the offset
+	// into it. When getting, this is unused and `bytes` points to Wren's string
+	size_t offset;
+	size_t length;
+	const char* bytes;
+	WrenHandle* handle;
+} wrengoValue;
+
+enum {
+	WRENGO_NULL,
+	WRENGO_BOOL,
+	WRENGO_NUM,
+	WRENGO_STRING,
+	WRENGO_HANDLE
+};
+
+static void wrengoSetSlots(WrenVM* vm, int start, wrengoValue* values, int count, const char* buffer) {
+	wrenEnsureSlots(vm, start + count);
+	for (int i = 0; i < count; i++) {
+		wrengoValue* value = &values[i];
+		int slot = start + i;
+		switch (value->type) {
+		case WRENGO_BOOL:
+			wrenSetSlotBool(vm, slot, value->boolean);
+			break;
+		case WRENGO_NUM:
+			wrenSetSlotDouble(vm, slot, value->number);
+			break;
+		case WRENGO_STRING:
+			wrenSetSlotBytes(vm, slot, buffer != NULL ? buffer + value->offset : "", value->length);
+			break;
+		case WRENGO_HANDLE:
+			wrenSetSlotHandle(vm, slot, value->handle);
+			break;
+		default:
+			wrenSetSlotNull(vm, slot);
+		}
+	}
+}
+
+// Unlike wrengoSetSlots, `type` is set to the slot's WrenType
+static void wrengoGetSlot(WrenVM* vm, int slot, wrengoValue* value) {
+	value->type = wrenGetSlotType(vm, slot);
+	switch (value->type) {
+	case WREN_TYPE_BOOL:
+		value->boolean = wrenGetSlotBool(vm, slot);
+		break;
+	case WREN_TYPE_NUM:
+		value->number = wrenGetSlotDouble(vm, slot);
+		break;
+	case WREN_TYPE_STRING: {
+		int length;
+		value->bytes = wrenGetSlotBytes(vm, slot, &length);
+		value->length = length;
+		break;
+	}
+	case WREN_TYPE_NULL:
+		break;
+	default:
+		value->handle = wrenGetSlotHandle(vm, slot);
+	}
+}
+
+static void wrengoGetSlots(WrenVM* vm, int start, wrengoValue* values, int count) {
+	for (int i = 0; i < count; i++) {
+		wrengoGetSlot(vm, start + i, &values[i]);
+	}
+}
+
+// Reads every element of the list in `listSlot`, using `scratchSlot` to hold
+// each element while it is read. Strings point into Wren's memory so they
+// have to be copied before Wren runs again
+static void wrengoGetListElements(WrenVM* vm, int listSlot, int scratchSlot, wrengoValue* values, int count) {
+	for (int i = 0; i < count; i++) {
+		wrenGetListElement(vm, listSlot, i, scratchSlot);
+		wrengoGetSlot(vm, scratchSlot, &values[i]);
+	}
+}
+
+// Sets `count` pairs of keys and values in the slots after `mapSlot` in the map
+static void wrengoSetMapSlots(WrenVM* vm, int mapSlot, int count) {
+	for (int i = 0; i < count; i++) {
+		wrenSetMapValue(vm, mapSlot, mapSlot + 1 + i * 2, mapSlot + 2 + i * 2);
+	}
+}
+
+// Looks up the `count` keys in the slots after `mapSlot` that `found` is set
+// for, replacing each key with its value. `found` is set to whether the map
+// contains the key
+static void wrengoGetMapSlots(WrenVM* vm, int mapSlot, int count, bool* found, wrengoValue* values) {
+	for (int i = 0; i < count; i++) {
+		int slot = mapSlot + 1 + i;
+		if (!found[i]) {
+			continue;
+		}
+		found[i] = wrenGetMapContainsKey(vm, mapSlot, slot);
+		if (found[i]) {
+			wrenGetMapValue(vm, mapSlot, slot, slot);
+			wrengoGetSlot(vm, slot, &values[i]);
+		}
+	}
+}
+
+// Writes the values in the `count` slots after `listSlot` into the list,
+// starting at `index`. Values past the end of the list are appended, so an
+// index of -1 appends every value
+static void wrengoWriteListSlots(WrenVM* vm, int listSlot, int index, int count) {
+	int length = wrenGetListCount(vm, listSlot);
+	for (int i = 0; i < count; i++) {
+		if (index >= 0 && index + i < length) {
+			wrenSetListElement(vm, listSlot, index + i, listSlot + 1 + i);
+		} else {
+			wrenInsertInList(vm, listSlot, -1, listSlot + 1 + i);
+		}
+	}
+}
+*/
+import "C"
+import (
+	"fmt"
+	"reflect"
+	"time"
+	"unsafe"
+)
+
+// InvalidValue is returned if there was an attempt to pass a value to Wren that WrenGo cannot process. Go slices, arrays, and maps are copied into new Wren lists and maps unless `Config.StrictValues` is set, in which case they also send this error.
+type InvalidValue struct {
+	Value interface{}
+}
+
+func (err InvalidValue) Error() string {
+	return fmt.Sprintf("WrenGo does not know how to handle the value type \"%v\"", reflect.TypeOf(err.Value).String())
+}
+
+// NonMatchingVM is returned if there was an attempt to use a handle in a VM that it did not originate from
+type NonMatchingVM struct{}
+
+func (err *NonMatchingVM) Error() string {
+	return "Cannot set value to VM because it didn't originate from this VM"
+}
+
+type nullValue struct{}
+
+// Null is Wren's `null` value. A `ForeignMethodFn` that returns nil leaves its receiver as the return value in Wren, so return `Null` to explicitly return `null` instead
+var Null = nullValue{}
+
+// slotWriter stages values in Go so they can be written to slots with a single call into C
+type slotWriter struct {
+	vm     *VM
+	values []C.wrengoValue
+	buffer []byte
+	// lists and maps converted from Go values that are freed once they are in their slots
+	temps []interface{}
+}
+
+func (w *slotWriter) handle(handle *Handle) error {
+	if handle.VM() != w.vm {
+		w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+		return &NonMatchingVM{}
+	}
+	if !handle.live() {
+		w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+		return &NilHandleError{}
+	}
+	w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_HANDLE, handle: handle.handle})
+	return nil
+}
+
+func (w *slotWriter) bytes(data []byte) {
+	w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_STRING, offset: C.size_t(len(w.buffer)), length: C.size_t(len(data))})
+	w.buffer = append(w.buffer, data...)
+}
+
+func (w *slotWriter) number(value float64) {
+	w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NUM, number: C.double(value)})
+}
+
+// add stages `value`. If WrenGo does not know how to handle it, null is staged instead and an error is returned
+func (w *slotWriter) add(value interface{}) error {
+	switch value := value.(type) {
+	case nullValue:
+		w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+	case *Handle:
+		return w.handle(value)
+	case *ListHandle:
+		return w.handle(value.handle)
+	case *MapHandle:
+		return w.handle(value.handle)
+	case *ForeignHandle:
+		return w.handle(value.handle)
+	case *FiberHandle:
+		return w.handle(value.handle)
+	case *ClassHandle:
+		return w.handle(value.handle)
+	case *FnHandle:
+		return w.handle(value.handle)
+	case *RangeHandle:
+		return w.handle(value.handle)
+	case []byte:
+		w.bytes(value)
+	case bool:
+		w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_BOOL, boolean: C.bool(value)})
+	case string:
+		w.bytes([]byte(value))
+	case time.Time:
+		dateTime, err := w.vm.NewDateTime(value)
+		if err != nil {
+			w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+			return err
+		}
+		w.temps = append(w.temps, dateTime)
+		return w.handle(dateTime.handle)
+	default:
+		switch v := reflect.ValueOf(value); v.Kind() {
+		case reflect.Float32, reflect.Float64:
+			w.number(v.Float())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			w.number(float64(v.Int()))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			w.number(float64(v.Uint()))
+		case reflect.Invalid:
+			w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+		case reflect.Slice, reflect.Array, reflect.Map:
+			if w.vm.Config != nil && w.vm.Config.StrictValues {
+				w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+				return &InvalidValue{Value: value}
+			}
+			converted, err := w.vm.fromGo(v)
+			if err != nil {
+				w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+				return err
+			}
+			w.temps = append(w.temps, converted)
+			return w.handle(converted.(freeableHandle).Handle())
+		default:
+			w.values = append(w.values, C.wrengoValue{_type: C.WRENGO_NULL})
+			return &InvalidValue{Value: value}
+		}
+	}
+	return nil
+}
+
+// flush writes the staged values to the slots starting at `start`, ensuring there are enough slots
+func (w *slotWriter) flush(start int) {
+	if len(w.values) == 0 {
+		return
+	}
+	var buffer *C.char
+	if len(w.buffer) > 0 {
+		buffer = (*C.char)(unsafe.Pointer(&w.buffer[0]))
+	}
+	C.wrengoSetSlots(w.vm.vm, C.int(start), &w.values[0], C.int(len(w.values)), buffer)
+	w.vm.FreeAll(w.temps...)
+}
+
+type freeableHandle interface {
+	Handle() *Handle
+}
+
+// fromGo copies a Go slice, array, or map into a new Wren list or map. Elements are converted the same way as any other value passed to Wren
+func (vm *VM) fromGo(v reflect.Value) (interface{}, error) {
+	if v.Kind() == reflect.Map {
+		m, err := vm.NewMap()
+		if err != nil {
+			return nil, err
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := m.Set(iter.Key().Interface(), iter.Value().Interface()); err != nil {
+				m.Free()
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+	list, err := vm.NewList()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, v.Len())
+	for i := range values {
+		values[i] = v.Index(i).Interface()
+	}
+	if err := list.Append(values...); err != nil {
+		list.Free()
+		return nil, err
+	}
+	return list, nil
+}
+
+// writeList writes `values` into `list` starting at `index` (see `wrengoWriteListSlots`). Every value is converted before anything is written, so the list is left as it was if one can't be passed to Wren
+func (vm *VM) writeList(list *Handle, index int, values []interface{}) error {
+	if len(values) == 0 {
+		return nil
+	}
+	base := vm.reserveSlots(1 + len(values))
+	defer vm.releaseSlots(base)
+	w := slotWriter{vm: vm, values: make([]C.wrengoValue, 0, 1+len(values))}
+	for _, value := range append([]interface{}{list}, values...) {
+		if err := w.add(value); err != nil {
+			vm.FreeAll(w.temps...)
+			return err
+		}
+	}
+	w.flush(base)
+	C.wrengoWriteListSlots(vm.vm, C.int(base), C.int(index), C.int(len(values)))
+	return nil
+}
+
+// writeMap sets every key in `values` in `m` with a single call into C (see `MapHandle.SetAll`)
+func (vm *VM) writeMap(m *MapHandle, values map[interface{}]interface{}) error {
+	base := vm.reserveSlots(1 + len(values)*2)
+	defer vm.releaseSlots(base)
+	w := slotWriter{vm: vm, values: make([]C.wrengoValue, 0, 1+len(values)*2)}
+	if err := w.add(m); err != nil {
+		return err
+	}
+	errs := make(KeyErrors)
+	for key, value := range values {
+		if !validMapKey(key) {
+			errs[key] = &InvalidKey{Map: m, Key: key}
+			continue
+		}
+		w.add(key)
+		if err := w.add(value); err != nil {
+			errs[key] = err
+			w.values = w.values[:len(w.values)-2]
+		}
+	}
+	w.flush(base)
+	C.wrengoSetMapSlots(vm.vm, C.int(base), C.int((len(w.values)-1)/2))
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// readMap looks up every key in `keys` in `m` with a single call into C (see `MapHandle.GetMany`)
+func (vm *VM) readMap(m *MapHandle, keys []interface{}) ([]MapResult, error) {
+	results := make([]MapResult, len(keys))
+	if len(keys) == 0 {
+		return results, nil
+	}
+	base := vm.reserveSlots(1 + len(keys))
+	defer vm.releaseSlots(base)
+	w := slotWriter{vm: vm, values: make([]C.wrengoValue, 0, 1+len(keys))}
+	if err := w.add(m); err != nil {
+		return nil, err
+	}
+	found := make([]C.bool, len(keys))
+	for i, key := range keys {
+		if validMapKey(key) {
+			w.add(key)
+			found[i] = true
+		} else {
+			w.add(nil)
+			results[i].Err = &InvalidKey{Map: m, Key: key}
+		}
+	}
+	w.flush(base)
+	staged := make([]C.wrengoValue, len(keys))
+	C.wrengoGetMapSlots(vm.vm, C.int(base), C.int(len(keys)), &found[0], &staged[0])
+	for i, key := range keys {
+		switch {
+		case bool(found[i]):
+			results[i].Value = vm.fromStaged(staged[i])
+		case results[i].Err == nil:
+			results[i].Err = &KeyNotExist{Map: m, Key: key}
+		}
+	}
+	return results, nil
+}
+
+// validMapKey reports whether `key` can be passed to Wren as a map key without having to check the slot it is put in
+func validMapKey(key interface{}) bool {
+	switch key.(type) {
+	case nullValue, bool, string, []byte:
+		return true
+	}
+	switch reflect.ValueOf(key).Kind() {
+	case reflect.Invalid, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// reserveSlots makes sure there are `count` slots that only the caller uses until `releaseSlots` is called with the returned base slot. Slots below the base belong to something still in flight, such as a foreign method's parameters or another handle's operation
+func (vm *VM) reserveSlots(count int) int {
+	base := vm.slotTop
+	vm.slotTop += count
+	C.wrenEnsureSlots(vm.vm, C.int(vm.slotTop))
+	return base
+}
+
+// releaseSlots gives back the slots reserved from `base` upwards
+func (vm *VM) releaseSlots(base int) {
+	vm.slotTop = base
+}
+
+// setSlots writes `values` into the slots starting at `start` with a single call into C. Values that could not be converted are set to null and the first error is returned
+func (vm *VM) setSlots(start int, values ...interface{}) error {
+	w := slotWriter{vm: vm, values: make([]C.wrengoValue, 0, len(values))}
+	var firstErr error
+	for _, value := range values {
+		if err := w.add(value); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	w.flush(start)
+	return firstErr
+}
+
+func (vm *VM) setSlotValue(value interface{}, slot int) error {
+	return vm.setSlots(slot, value)
+}
+
+// getSlots reads `count` slots starting at `start` with a single call into C
+func (vm *VM) getSlots(start, count int) []interface{} {
+	values := make([]interface{}, count)
+	if count == 0 {
+		return values
+	}
+	staged := make([]C.wrengoValue, count)
+	C.wrengoGetSlots(vm.vm, C.int(start), &staged[0], C.int(count))
+	for i, value := range staged {
+		values[i] = vm.fromStaged(value)
+	}
+	return values
+}
+
+// getListElements reads every element of the list in `listSlot` with a single call into C
+func (vm *VM) getListElements(listSlot, scratchSlot int) []interface{} {
+	count := int(C.wrenGetListCount(vm.vm, C.int(listSlot)))
+	values := make([]interface{}, count)
+	if count == 0 {
+		return values
+	}
+	staged := make([]C.wrengoValue, count)
+	C.wrengoGetListElements(vm.vm, C.int(listSlot), C.int(scratchSlot), &staged[0], C.int(count))
+	for i, value := range staged {
+		values[i] = vm.fromStaged(value)
+	}
+	return values
+}
+
+func (vm *VM) fromStaged(value C.wrengoValue) interface{} {
+	switch value._type {
+	case C.WREN_TYPE_BOOL:
+		return bool(value.boolean)
+	case C.WREN_TYPE_NUM:
+		return float64(value.number)
+	case C.WREN_TYPE_FOREIGN:
+		return &ForeignHandle{handle: vm.createHandle(value.handle)}
+	case C.WREN_TYPE_LIST:
+		return &ListHandle{handle: vm.createHandle(value.handle)}
+	case C.WREN_TYPE_MAP:
+		return &MapHandle{handle: vm.createHandle(value.handle)}
+	case C.WREN_TYPE_NULL:
+		return nil
+	case C.WREN_TYPE_STRING:
+		return string(C.GoBytes(unsafe.Pointer(value.bytes), C.int(value.length)))
+	case C.WREN_TYPE_UNKNOWN:
+		return vm.objectHandle(value.handle)
+	default:
+		panic("Unreachable")
+	}
+}
+
+func (vm *VM) getSlotValue(slot int) (value interface{}) {
+	return vm.getSlots(slot, 1)[0]
+}
+
+func (vm *VM) getAllSlots() []interface{} {
+	return vm.getSlots(0, int(C.wrenGetSlotCount(vm.vm)))
+}
@@ -0,0 +1,96 @@
+package wren
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"sync"
+)
+
+// inputState is the buffered reader for a VM's input. The same reader is kept between reads so buffered input isn't lost. Reads happen on other goroutines (see `Async`), so it is locked while reading and fibers reading at the same time get the input in no particular order
+type inputState struct {
+	mux    sync.Mutex
+	reader *bufio.Reader
+	from   io.Reader
+}
+
+// NewStdinModule creates a module with a `Stdin` class so scripts can read input from `Config.DefaultInput` (or the global `DefaultInput` if the config doesn't set one). It is usually set as the "io" module so scripts written for wren-cli work:
+//
+//	vm.SetModule("io", wren.NewStdinModule())
+//
+// Scripts can then use `Stdin.readLine()` to read the next line (without the line ending) and `Stdin.readAll()` to read everything that is left. Both return null when there is nothing left to read (or no input is set). Like `Timer.sleep`, the fiber reading waits without blocking the VM while input is read on another goroutine, so the interpretation or call that was running returns and the fiber is resumed by `VM.Run` or `VM.Poll` once the input is there
+func NewStdinModule() *Module {
+	module := NewModule(ClassMap{
+		"Stdin": NewClass(nil, nil, MethodMap{
+			"static readLine_(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return Null, vm.readInput(parameters[1], func(input *bufio.Reader) (interface{}, error) {
+					line, err := input.ReadString('\n')
+					if line == "" && err == io.EOF {
+						return nil, nil
+					}
+					if err != nil && err != io.EOF {
+						return nil, err
+					}
+					return strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"), nil
+				})
+			},
+			"static readAll_(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return Null, vm.readInput(parameters[1], func(input *bufio.Reader) (interface{}, error) {
+					data, err := io.ReadAll(input)
+					if err != nil {
+						return nil, err
+					}
+					if len(data) == 0 {
+						return nil, nil
+					}
+					return string(data), nil
+				})
+			},
+		}),
+	})
+	module.Source = `
+class Stdin {
+	static readLine() {
+		readLine_(Fiber.current)
+		return Fiber.suspend()
+	}
+	static readAll() {
+		readAll_(Fiber.current)
+		return Fiber.suspend()
+	}
+	foreign static readLine_(fiber)
+	foreign static readAll_(fiber)
+}
+`
+	return module
+}
+
+// readInput calls `read` with the VM's input on another goroutine and resumes `fiber` with what it returns. If there is no input, the fiber is resumed with null
+func (vm *VM) readInput(fiber interface{}, read func(input *bufio.Reader) (interface{}, error)) error {
+	handle, ok := fiber.(*FiberHandle)
+	if !ok {
+		return &UnexpectedValue{Value: fiber}
+	}
+	state := &vm.input
+	state.mux.Lock()
+	var input io.Reader
+	if vm.Config != nil && vm.Config.DefaultInput != nil {
+		input = vm.Config.DefaultInput
+	} else if DefaultInput != nil {
+		input = DefaultInput
+	}
+	if input != nil && (state.reader == nil || state.from != input) {
+		state.reader = bufio.NewReader(input)
+		state.from = input
+	}
+	reader := state.reader
+	state.mux.Unlock()
+	return vm.Async(handle, func() (interface{}, error) {
+		if input == nil {
+			return nil, nil
+		}
+		state.mux.Lock()
+		defer state.mux.Unlock()
+		return read(reader)
+	})
+}
@@ -0,0 +1,117 @@
+package stdlib
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"hash"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// UnknownAlgorithm is returned from `Hash.hmac` if the algorithm isn't one the "crypto" module supports
+type UnknownAlgorithm struct {
+	Name string
+}
+
+func (err *UnknownAlgorithm) Error() string {
+	return fmt.Sprintf("Unknown hash algorithm \"%v\" (expected md5, sha1, sha256 or sha512)", err.Name)
+}
+
+// MaxRandomBytes is the most bytes `SecureRandom` returns at once, so scripts can't make the host allocate as much memory as they like
+const MaxRandomBytes = 1 << 16
+
+// InvalidLength is returned from `SecureRandom` if it is asked for a negative amount of bytes or more than `MaxRandomBytes`. Counts that aren't whole numbers are rejected before this when they are converted to an int
+type InvalidLength struct {
+	Length int
+}
+
+func (err *InvalidLength) Error() string {
+	return fmt.Sprintf("Length must be between 0 and %v but got %v", MaxRandomBytes, err.Length)
+}
+
+var algorithms = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// NewCryptoModule creates the "crypto" module. `Hash` returns digests as lowercase hex strings and `SecureRandom` reads from the operating system's secure random number generator:
+//
+//	Hash.md5(data), Hash.sha1(data), Hash.sha256(data), Hash.sha512(data), Hash.hmac(algorithm, key, data), Hash.equal(a, b)
+//	SecureRandom.bytes(count), SecureRandom.hex(count)
+//
+// `Hash.equal` compares strings in constant time so it can be used to check signatures such as the ones sent with webhooks. `SecureRandom.bytes` returns a string of raw bytes.
+func NewCryptoModule() *wren.Module {
+	digest := func(algorithm string) wren.ForeignMethodFn {
+		return wren.Method1(func(vm *wren.VM, data string) (interface{}, error) {
+			h := algorithms[algorithm]()
+			h.Write([]byte(data))
+			return hex.EncodeToString(h.Sum(nil)), nil
+		})
+	}
+	module := wren.NewModule(wren.ClassMap{
+		"Hash": wren.NewClass(nil, nil, wren.MethodMap{
+			"static md5(_)":    digest("md5"),
+			"static sha1(_)":   digest("sha1"),
+			"static sha256(_)": digest("sha256"),
+			"static sha512(_)": digest("sha512"),
+			"static hmac(_,_,_)": wren.Method3(func(vm *wren.VM, algorithm, key, data string) (interface{}, error) {
+				newHash, ok := algorithms[algorithm]
+				if !ok {
+					return nil, &UnknownAlgorithm{Name: algorithm}
+				}
+				mac := hmac.New(newHash, []byte(key))
+				mac.Write([]byte(data))
+				return hex.EncodeToString(mac.Sum(nil)), nil
+			}),
+			"static equal(_,_)": wren.Method2(func(vm *wren.VM, a, b string) (interface{}, error) {
+				return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1, nil
+			}),
+		}),
+		"SecureRandom": wren.NewClass(nil, nil, wren.MethodMap{
+			"static bytes(_)": wren.Method1(func(vm *wren.VM, count int) (interface{}, error) {
+				data, err := randomBytes(count)
+				return string(data), err
+			}),
+			"static hex(_)": wren.Method1(func(vm *wren.VM, count int) (interface{}, error) {
+				data, err := randomBytes(count)
+				return hex.EncodeToString(data), err
+			}),
+		}),
+	})
+	module.Source = `
+class Hash {
+	foreign static md5(data)
+	foreign static sha1(data)
+	foreign static sha256(data)
+	foreign static sha512(data)
+	foreign static hmac(algorithm, key, data)
+	foreign static equal(a, b)
+}
+
+class SecureRandom {
+	foreign static bytes(count)
+	foreign static hex(count)
+}
+`
+	return module
+}
+
+func randomBytes(count int) ([]byte, error) {
+	if count < 0 || count > MaxRandomBytes {
+		return nil, &InvalidLength{Length: count}
+	}
+	data := make([]byte, count)
+	if _, err := rand.Read(data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,93 @@
+package stdlib
+
+import (
+	"os"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// NewIOModule creates the "io" module. It has the `Stdin` class from `wren.NewStdinModule` and the `File` and `Directory` classes for reading and writing files:
+//
+//	File.read(path), File.write(path, text), File.append(path, text), File.exists(path), File.size(path), File.delete(path)
+//	Directory.list(path), Directory.exists(path), Directory.create(path), Directory.delete(path)
+func NewIOModule() *wren.Module {
+	module := wren.NewStdinModule()
+	module.ClassMap["File"] = wren.NewClass(nil, nil, wren.MethodMap{
+		"static read(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			return string(data), nil
+		}),
+		"static write(_,_)": wren.Method2(func(vm *wren.VM, path, text string) (interface{}, error) {
+			return wren.Null, os.WriteFile(path, []byte(text), 0666)
+		}),
+		"static append(_,_)": wren.Method2(func(vm *wren.VM, path, text string) (interface{}, error) {
+			file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0666)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := file.WriteString(text); err != nil {
+				file.Close()
+				return nil, err
+			}
+			return wren.Null, file.Close()
+		}),
+		"static exists(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			info, err := os.Stat(path)
+			return err == nil && !info.IsDir(), nil
+		}),
+		"static size(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, err
+			}
+			return float64(info.Size()), nil
+		}),
+		"static delete(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			return wren.Null, os.Remove(path)
+		}),
+	})
+	module.ClassMap["Directory"] = wren.NewClass(nil, nil, wren.MethodMap{
+		"static list(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			entries, err := os.ReadDir(path)
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, len(entries))
+			for i, entry := range entries {
+				names[i] = entry.Name()
+			}
+			return vm.Return(names)
+		}),
+		"static exists(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			info, err := os.Stat(path)
+			return err == nil && info.IsDir(), nil
+		}),
+		"static create(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			return wren.Null, os.MkdirAll(path, 0777)
+		}),
+		"static delete(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+			return wren.Null, os.Remove(path)
+		}),
+	})
+	module.Source += `
+class File {
+	foreign static read(path)
+	foreign static write(path, text)
+	foreign static append(path, text)
+	foreign static exists(path)
+	foreign static size(path)
+	foreign static delete(path)
+}
+
+class Directory {
+	foreign static list(path)
+	foreign static exists(path)
+	foreign static create(path)
+	foreign static delete(path)
+}
+`
+	return module
+}
@@ -0,0 +1,73 @@
+package stdlib
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// NewOSModule creates the "os" module. `Platform` describes the operating system and `Env` reads and changes environment variables:
+//
+//	Platform.name, Platform.arch, Platform.isWindows, Platform.homePath
+//	Env.get(name), Env.set(name, value), Env.unset(name), Env.all
+//
+// `Env.get` returns null for variables that aren't set.
+func NewOSModule() *wren.Module {
+	module := wren.NewModule(wren.ClassMap{
+		"Platform": wren.NewClass(nil, nil, wren.MethodMap{
+			"static name": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return runtime.GOOS, nil
+			}),
+			"static arch": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return runtime.GOARCH, nil
+			}),
+			"static isWindows": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return runtime.GOOS == "windows", nil
+			}),
+			"static homePath": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return os.UserHomeDir()
+			}),
+		}),
+		"Env": wren.NewClass(nil, nil, wren.MethodMap{
+			"static get(_)": wren.Method1(func(vm *wren.VM, name string) (interface{}, error) {
+				if value, ok := os.LookupEnv(name); ok {
+					return value, nil
+				}
+				return wren.Null, nil
+			}),
+			"static set(_,_)": wren.Method2(func(vm *wren.VM, name, value string) (interface{}, error) {
+				return wren.Null, os.Setenv(name, value)
+			}),
+			"static unset(_)": wren.Method1(func(vm *wren.VM, name string) (interface{}, error) {
+				return wren.Null, os.Unsetenv(name)
+			}),
+			"static all": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				env := make(map[string]string)
+				for _, entry := range os.Environ() {
+					if name, value, ok := strings.Cut(entry, "="); ok {
+						env[name] = value
+					}
+				}
+				return vm.Return(env)
+			}),
+		}),
+	})
+	module.Source = `
+class Platform {
+	foreign static name
+	foreign static arch
+	foreign static isWindows
+	foreign static homePath
+}
+
+class Env {
+	foreign static get(name)
+	foreign static set(name, value)
+	foreign static unset(name)
+	foreign static all
+}
+`
+	return module
+}
@@ -0,0 +1,54 @@
+package stdlib
+
+import (
+	"path/filepath"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// NewPathModule creates the "path" module. Its `Path` class works with file paths using the host's separator without touching the file system:
+//
+//	Path.join(a, b), Path.join(list), Path.base(path), Path.dir(path), Path.ext(path), Path.clean(path), Path.isAbsolute(path), Path.separator
+func NewPathModule() *wren.Module {
+	module := wren.NewModule(wren.ClassMap{
+		"Path": wren.NewClass(nil, nil, wren.MethodMap{
+			"static join(_,_)": wren.Method2(func(vm *wren.VM, a, b string) (interface{}, error) {
+				return filepath.Join(a, b), nil
+			}),
+			"static join(_)": wren.Method1(func(vm *wren.VM, parts []string) (interface{}, error) {
+				return filepath.Join(parts...), nil
+			}),
+			"static base(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+				return filepath.Base(path), nil
+			}),
+			"static dir(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+				return filepath.Dir(path), nil
+			}),
+			"static ext(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+				return filepath.Ext(path), nil
+			}),
+			"static clean(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+				return filepath.Clean(path), nil
+			}),
+			"static isAbsolute(_)": wren.Method1(func(vm *wren.VM, path string) (interface{}, error) {
+				return filepath.IsAbs(path), nil
+			}),
+			"static separator": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return string(filepath.Separator), nil
+			}),
+		}),
+	})
+	module.Source = `
+class Path {
+	foreign static join(a, b)
+	foreign static join(parts)
+	foreign static base(path)
+	foreign static dir(path)
+	foreign static ext(path)
+	foreign static clean(path)
+	foreign static isAbsolute(path)
+	foreign static separator
+}
+`
+	return module
+}
@@ -0,0 +1,33 @@
+package stdlib
+
+import (
+	"os"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// NewProcessModule creates the "process" module. Its `Process` class gives scripts `args` as `Process.arguments` along with `Process.cwd` and `Process.pid`. The module in `Libraries` passes the arguments the program was started with (without the program name)
+func NewProcessModule(args []string) *wren.Module {
+	args = append([]string{}, args...)
+	module := wren.NewModule(wren.ClassMap{
+		"Process": wren.NewClass(nil, nil, wren.MethodMap{
+			"static arguments": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return vm.Return(args)
+			}),
+			"static cwd": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return os.Getwd()
+			}),
+			"static pid": wren.Method0(func(vm *wren.VM) (interface{}, error) {
+				return float64(os.Getpid()), nil
+			}),
+		}),
+	})
+	module.Source = `
+class Process {
+	foreign static arguments
+	foreign static cwd
+	foreign static pid
+}
+`
+	return module
+}
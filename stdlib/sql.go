@@ -0,0 +1,186 @@
+package stdlib
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"sync"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// UnknownDatabase is returned to scripts that open a database that wasn't registered with the "sql" module
+type UnknownDatabase struct {
+	Name string
+}
+
+func (err *UnknownDatabase) Error() string {
+	return fmt.Sprintf("Database \"%v\" has not been registered", err.Name)
+}
+
+// Databases holds the connections the "sql" module lets scripts use by name. It is safe for concurrent use, so connections can be added after the module is set in a VM
+type Databases struct {
+	mux       sync.RWMutex
+	databases map[string]*sql.DB
+}
+
+// NewDatabases creates an empty set of connections for `NewSQLModule`
+func NewDatabases() *Databases {
+	return &Databases{databases: make(map[string]*sql.DB)}
+}
+
+// Register lets scripts open `db` with `Database.open(name)`. The connection is still owned by the caller and is not closed by WrenGo
+func (d *Databases) Register(name string, db *sql.DB) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	d.databases[name] = db
+}
+
+// Unregister removes the connection registered as `name`
+func (d *Databases) Unregister(name string) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	delete(d.databases, name)
+}
+
+func (d *Databases) lookup(name string) (*sql.DB, error) {
+	d.mux.RLock()
+	defer d.mux.RUnlock()
+	db, ok := d.databases[name]
+	if !ok {
+		return nil, &UnknownDatabase{Name: name}
+	}
+	return db, nil
+}
+
+// NewSQLModule creates the "sql" module, which lets scripts query the connections in `databases` with parameterized queries:
+//
+//	var db = Database.open("reports")
+//	var rows = db.query("SELECT name, total FROM orders WHERE total > ?", [100])
+//	var changed = db.exec("DELETE FROM orders WHERE id = ?", [id])
+//
+// `query` returns a list with a map for each row keyed by column name and `exec` returns how many rows were changed. Numbers without a fraction are passed to the driver as integers, `DateTime` objects as `time.Time` and byte columns are returned as strings. It is not part of `Libraries` since it needs connections from Go, but it can be registered for VMs that grant `wren.CapabilitySQL`:
+//
+//	wren.RegisterOptionalModule("sql", wren.CapabilitySQL, stdlib.NewSQLModule(databases))
+func NewSQLModule(databases *Databases) *wren.Module {
+	module := wren.NewModule(wren.ClassMap{
+		"Database": wren.NewClass(nil, nil, wren.MethodMap{
+			"static check_(_)": wren.Method1(func(vm *wren.VM, name string) (interface{}, error) {
+				_, err := databases.lookup(name)
+				return wren.Null, err
+			}),
+			"static query_(_,_,_)": wren.Method3(func(vm *wren.VM, name, query string, params *wren.ListHandle) (interface{}, error) {
+				db, err := databases.lookup(name)
+				if err != nil {
+					return nil, err
+				}
+				args, err := queryArgs(params)
+				if err != nil {
+					return nil, err
+				}
+				rows, err := db.Query(query, args...)
+				if err != nil {
+					return nil, err
+				}
+				results, err := scanRows(rows)
+				if err != nil {
+					return nil, err
+				}
+				return vm.Return(results)
+			}),
+			"static exec_(_,_,_)": wren.Method3(func(vm *wren.VM, name, query string, params *wren.ListHandle) (interface{}, error) {
+				db, err := databases.lookup(name)
+				if err != nil {
+					return nil, err
+				}
+				args, err := queryArgs(params)
+				if err != nil {
+					return nil, err
+				}
+				result, err := db.Exec(query, args...)
+				if err != nil {
+					return nil, err
+				}
+				return result.RowsAffected()
+			}),
+		}),
+	})
+	module.Source = `
+class Database {
+	construct open(name) {
+		Database.check_(name)
+		_name = name
+	}
+	name { _name }
+	query(sql) { Database.query_(_name, sql, []) }
+	query(sql, params) { Database.query_(_name, sql, params) }
+	exec(sql) { Database.exec_(_name, sql, []) }
+	exec(sql, params) { Database.exec_(_name, sql, params) }
+	foreign static check_(name)
+	foreign static query_(name, sql, params)
+	foreign static exec_(name, sql, params)
+}
+`
+	return module
+}
+
+// queryArgs converts the parameters of a query into values database drivers accept
+func queryArgs(params *wren.ListHandle) ([]interface{}, error) {
+	values, err := params.ToSlice(false)
+	if err != nil {
+		return nil, err
+	}
+	defer params.VM().FreeAll(values...)
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		switch value := value.(type) {
+		case float64:
+			if value == math.Trunc(value) && math.Abs(value) < 1<<53 {
+				args[i] = int64(value)
+			} else {
+				args[i] = value
+			}
+		case *wren.ForeignHandle:
+			goValue, err := value.Get()
+			if err != nil {
+				return nil, err
+			}
+			args[i] = goValue
+		case nil, bool, string:
+			args[i] = value
+		default:
+			return nil, &wren.InvalidValue{Value: value}
+		}
+	}
+	return args, nil
+}
+
+// scanRows reads every row into a map keyed by column name and closes `rows`
+func scanRows(rows *sql.Rows) ([]map[string]interface{}, error) {
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	results := []map[string]interface{}{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			if data, ok := values[i].([]byte); ok {
+				row[column] = string(data)
+			} else {
+				row[column] = values[i]
+			}
+		}
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
@@ -0,0 +1,67 @@
+// Package stdlib provides ready-made foreign modules for reading files, working with paths, environment variables, process arguments, hashes and SQL databases. Modules that touch the system need a capability so embedders can choose what scripts are allowed to do:
+//
+//	vm.Merge(stdlib.ModuleMap(wren.CapabilityIO))
+//
+// Only modules whose capability is granted are included, so the VM above can import "io", "path" and "crypto" but not "os" or "process".
+package stdlib
+
+import (
+	"os"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// Library is a module of the standard library and the capability scripts need to import it. Modules that only compute values (like "path" and "crypto") need no capability
+type Library struct {
+	Name       string
+	Capability wren.Capability
+	New        func() *wren.Module
+}
+
+// Libraries lists every module in the standard library
+var Libraries = []Library{
+	{Name: "crypto", New: NewCryptoModule},
+	{Name: "io", Capability: wren.CapabilityIO, New: NewIOModule},
+	{Name: "os", Capability: wren.CapabilityOS, New: NewOSModule},
+	{Name: "path", New: NewPathModule},
+	{Name: "process", Capability: wren.CapabilityOS, New: func() *wren.Module {
+		return NewProcessModule(os.Args[1:])
+	}},
+}
+
+// Lookup returns the library named `name`
+func Lookup(name string) (Library, bool) {
+	for _, library := range Libraries {
+		if library.Name == name {
+			return library, true
+		}
+	}
+	return Library{}, false
+}
+
+// ModuleMap creates the modules of the standard library whose capability is in `granted`, ready to be passed to `VM.Merge`. Modules that need no capability are always included
+func ModuleMap(granted ...wren.Capability) wren.ModuleMap {
+	modules := make(wren.ModuleMap)
+	for _, library := range Libraries {
+		if library.Capability == "" || hasCapability(granted, library.Capability) {
+			modules[library.Name] = library.New()
+		}
+	}
+	return modules
+}
+
+// Register registers every module of the standard library with `wren.RegisterOptionalModule`, so any VM can import the modules its config grants the capabilities for
+func Register() {
+	for _, library := range Libraries {
+		wren.RegisterOptionalModule(library.Name, library.Capability, library.New())
+	}
+}
+
+func hasCapability(granted []wren.Capability, capability wren.Capability) bool {
+	for _, c := range granted {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
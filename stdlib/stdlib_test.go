@@ -0,0 +1,221 @@
+package stdlib
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+func TestStdlib(t *testing.T) {
+	dir := t.TempDir()
+	os.Setenv("WRENGO_STDLIB_TEST", "set")
+	defer os.Unsetenv("WRENGO_STDLIB_TEST")
+
+	modules := ModuleMap(wren.CapabilityIO)
+	if _, ok := modules["os"]; ok {
+		t.Error("Expected the os module to need its capability")
+	}
+	if _, ok := modules["path"]; !ok {
+		t.Error("Expected the path module to be included")
+	}
+	cfg := wren.NewConfig()
+	cfg.ErrorFn = func(vm *wren.VM, err error) { t.Logf("error> %v", err) }
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.Merge(modules)
+	vm.SetModule("os", NewOSModule())
+	vm.SetModule("process", NewProcessModule([]string{"first", "second"}))
+	err := vm.InterpretString("main", fmt.Sprintf(`
+	import "io" for File, Directory
+	import "path" for Path
+	import "os" for Env
+	import "process" for Process
+	var file = Path.join(%q, "test.txt")
+	File.write(file, "hello")
+	File.append(file, " world")
+	var text = File.read(file)
+	var size = File.size(file)
+	var names = Directory.list(%q).join(",")
+	var name = Path.base(Path.join(["a", "b", "c.wren"]))
+	var env = Env.get("WRENGO_STDLIB_TEST")
+	var all = Env.all["WRENGO_STDLIB_TEST"]
+	var missing = Env.get("WRENGO_STDLIB_MISSING")
+	var args = Process.arguments.join(",")
+	`, dir, dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"text": "hello world", "size": 11.0, "names": "test.txt", "name": "c.wren", "env": "set", "all": "set", "missing": nil, "args": "first,second"}
+	for name, value := range expected {
+		if v, _ := vm.GetVariable("main", name); v != value {
+			t.Errorf("Expected %v to be %v but got %v", name, value, v)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register()
+	defer func() {
+		for _, library := range Libraries {
+			wren.UnregisterOptionalModule(library.Name)
+		}
+	}()
+	cfg := wren.NewConfig()
+	var denied *wren.CapabilityDenied
+	cfg.ErrorFn = func(vm *wren.VM, err error) {
+		if d, ok := err.(*wren.CapabilityDenied); ok {
+			denied = d
+		}
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `import "path" for Path`); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.InterpretString("main", `import "io" for File`); err == nil || denied == nil {
+		t.Errorf("Expected io to need %v", wren.CapabilityIO)
+	}
+}
+
+func TestCrypto(t *testing.T) {
+	vm := wren.NewConfig().NewVM()
+	defer vm.Free()
+	vm.SetModule("crypto", NewCryptoModule())
+	err := vm.InterpretString("main", `
+	import "crypto" for Hash, SecureRandom
+	var sha = Hash.sha256("abc")
+	var md5 = Hash.md5("")
+	var mac = Hash.hmac("sha256", "key", "The quick brown fox jumps over the lazy dog")
+	var same = Hash.equal(mac, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8")
+	var random = SecureRandom.bytes(16).bytes.count
+	var hex = SecureRandom.hex(4).count
+	var unknown = Fiber.new { Hash.hmac("crc", "", "") }.try()
+	var tooMany = Fiber.new { SecureRandom.bytes(1e12) }.try()
+	var fraction = Fiber.new { SecureRandom.hex(1.5) }.try()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"sha":    "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		"md5":    "d41d8cd98f00b204e9800998ecf8427e",
+		"same":   true,
+		"random": 16.0,
+		"hex":    8.0,
+	}
+	for name, value := range expected {
+		if v, _ := vm.GetVariable("main", name); v != value {
+			t.Errorf("Expected %v to be %v but got %v", name, value, v)
+		}
+	}
+	for _, name := range []string{"tooMany", "fraction"} {
+		if v, _ := vm.GetVariable("main", name); v == nil {
+			t.Errorf("Expected %v to abort the fiber", name)
+		}
+	}
+	if v, _ := vm.GetVariable("main", "unknown"); v == nil {
+		t.Error("Expected unknown algorithm to abort")
+	}
+}
+
+// fakeDriver answers every query with the arguments it was given as a single row
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(len(args)), nil
+}
+func (fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &fakeRows{values: args}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *fakeRows) Columns() []string {
+	columns := make([]string, len(r.values))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%v", i)
+	}
+	return columns
+}
+func (r *fakeRows) Close() error { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func TestSQL(t *testing.T) {
+	sql.Register("wrengo-fake", fakeDriver{})
+	db, err := sql.Open("wrengo-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	databases := NewDatabases()
+	databases.Register("reports", db)
+
+	cfg := wren.NewConfig()
+	cfg.ErrorFn = func(vm *wren.VM, err error) { t.Logf("error> %v", err) }
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("sql", NewSQLModule(databases))
+	err = vm.InterpretString("main", `
+	import "sql" for Database
+	import "wrengo/datetime" for DateTime
+	var db = Database.open("reports")
+	var rows = db.query("SELECT ?, ?, ?, ?", [1, 2.5, "text", DateTime.unix(0)])
+	var row = rows[0]
+	var changed = db.exec("DELETE", [1, 2])
+	var missing = Fiber.new { Database.open("missing") }.try()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"changed": 2.0, "missing": "Database \"missing\" has not been registered"}
+	for name, value := range expected {
+		if v, _ := vm.GetVariable("main", name); v != value {
+			t.Errorf("Expected %v to be %v but got %v", name, value, v)
+		}
+	}
+	row, err := wren.VarAs[*wren.MapHandle](vm, "main", "row")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer row.Free()
+	values, err := row.ToMap(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["c0"] != 1.0 || values["c1"] != 2.5 || values["c2"] != "text" {
+		t.Errorf("Unexpected row %v", values)
+	}
+	var date time.Time
+	if err := vm.Unmarshal(values["c3"], &date); err != nil || date.Unix() != 0 {
+		t.Errorf("Expected the epoch but got %v (%v)", date, err)
+	}
+	vm.FreeAll(values["c3"])
+}
@@ -0,0 +1,152 @@
+package wren
+
+/*
+#include <stdint.h>
+
+static _Thread_local int64_t wrengoThread;
+static int64_t wrengoLastThread;
+
+// wrengoThreadID numbers OS threads the first time they ask
+static int64_t wrengoThreadID(void) {
+	if (wrengoThread == 0) {
+		wrengoThread = __atomic_add_fetch(&wrengoLastThread, 1, __ATOMIC_RELAXED);
+	}
+	return wrengoThread;
+}
+*/
+import "C"
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// SyncVM wraps a VM so it can be shared between goroutines. Every method locks the VM until it returns, so only one goroutine uses the VM at a time.
+//
+// Foreign methods run while the lock is held, so they must use the `*VM` they are given instead of the `SyncVM`. Calling the `SyncVM` from the goroutine that holds its lock, or from a foreign method on the thread the VM is pinned to with `Config.PinToThread`, returns `ReentrantCallError` instead of deadlocking. Handles that are returned should only be used inside of `Do`
+type SyncVM struct {
+	vm    *VM
+	mux   sync.Mutex
+	owner int64
+	// the thread the VM is pinned to with `Config.PinToThread`, where its foreign methods run instead of on the thread that holds the lock
+	thread int64
+}
+
+// ReentrantCallError is returned from a `SyncVM` if it is called by the goroutine that is already using it, such as from a foreign method. `RunningVMError`, which the VM returns if it is called into while running, unwraps to it too
+type ReentrantCallError struct{}
+
+func (err *ReentrantCallError) Error() string {
+	return "VM was called while it was already in use by the same goroutine (foreign methods should use the VM they are given and can't call into Wren)"
+}
+
+// NewSyncVM wraps `vm` in a `SyncVM`. `vm` should not be used directly afterwards
+func NewSyncVM(vm *VM) *SyncVM {
+	s := &SyncVM{vm: vm}
+	if vm.thread != nil {
+		s.thread = vm.thread.id
+	}
+	return s
+}
+
+// threadID returns a number for the calling OS thread. It only tells goroutines apart while they are locked to their thread with `runtime.LockOSThread`, since no other goroutine runs on a locked thread
+func threadID() int64 {
+	return int64(C.wrengoThreadID())
+}
+
+// lock locks the VM for the calling goroutine. The goroutine stays on its thread while it holds the lock, so the thread it owns the lock from tells whether it calls the `SyncVM` again
+func (s *SyncVM) lock() error {
+	runtime.LockOSThread()
+	id := threadID()
+	if owner := atomic.LoadInt64(&s.owner); owner == id || owner != 0 && id == s.thread {
+		runtime.UnlockOSThread()
+		return &ReentrantCallError{}
+	}
+	s.mux.Lock()
+	atomic.StoreInt64(&s.owner, id)
+	return nil
+}
+
+func (s *SyncVM) unlock() {
+	atomic.StoreInt64(&s.owner, 0)
+	s.mux.Unlock()
+	runtime.UnlockOSThread()
+}
+
+// Do calls `fn` with the VM locked. Anything not covered by the other methods, such as using handles, should be done here
+func (s *SyncVM) Do(fn func(vm *VM) error) error {
+	if err := s.lock(); err != nil {
+		return err
+	}
+	defer s.unlock()
+	return fn(s.vm)
+}
+
+// InterpretString is like `VM.InterpretString` but locks the VM while it runs
+func (s *SyncVM) InterpretString(module, source string) error {
+	return s.Do(func(vm *VM) error {
+		return vm.InterpretString(module, source)
+	})
+}
+
+// InterpretStringContext is like `VM.InterpretStringContext` but locks the VM while it runs
+func (s *SyncVM) InterpretStringContext(ctx context.Context, module, source string) error {
+	return s.Do(func(vm *VM) error {
+		return vm.InterpretStringContext(ctx, module, source)
+	})
+}
+
+// InterpretFile is like `VM.InterpretFile` but locks the VM while it runs
+func (s *SyncVM) InterpretFile(fileName string) error {
+	return s.Do(func(vm *VM) error {
+		return vm.InterpretFile(fileName)
+	})
+}
+
+// Call is like `VM.Call` but locks the VM while it runs
+func (s *SyncVM) Call(module, variable, signature string, args ...interface{}) (value interface{}, err error) {
+	err = s.Do(func(vm *VM) error {
+		value, err = vm.Call(module, variable, signature, args...)
+		return err
+	})
+	return value, err
+}
+
+// CallStatic is like `VM.CallStatic` but locks the VM while it runs
+func (s *SyncVM) CallStatic(module, class, signature string, args ...interface{}) (value interface{}, err error) {
+	err = s.Do(func(vm *VM) error {
+		value, err = vm.CallStatic(module, class, signature, args...)
+		return err
+	})
+	return value, err
+}
+
+// GetVariable is like `VM.GetVariable` but locks the VM while it runs
+func (s *SyncVM) GetVariable(module, name string) (value interface{}, err error) {
+	err = s.Do(func(vm *VM) error {
+		value, err = vm.GetVariable(module, name)
+		return err
+	})
+	return value, err
+}
+
+// Interrupt is like `VM.Interrupt`. It doesn't wait for the VM to be unlocked so it can stop the script that is holding the lock
+func (s *SyncVM) Interrupt() {
+	s.vm.Interrupt()
+}
+
+// GC is like `VM.GC` but locks the VM while it runs
+func (s *SyncVM) GC() error {
+	return s.Do(func(vm *VM) error {
+		vm.GC()
+		return nil
+	})
+}
+
+// Free locks the VM and frees it
+func (s *SyncVM) Free() error {
+	return s.Do(func(vm *VM) error {
+		vm.Free()
+		return nil
+	})
+}
@@ -0,0 +1,84 @@
+package wren
+
+import "runtime"
+
+// osThread runs functions one at a time on a goroutine locked to its own OS thread, for `Config.PinToThread`
+type osThread struct {
+	calls chan func()
+	// the thread running the calls (see `threadID`), so calls made from it (like from foreign methods) run right away
+	id int64
+}
+
+// startThread starts the goroutine of a new thread
+func startThread() *osThread {
+	t := &osThread{calls: make(chan func())}
+	started := make(chan struct{})
+	go func() {
+		runtime.LockOSThread()
+		t.id = threadID()
+		close(started)
+		for fn := range t.calls {
+			fn()
+		}
+		// the goroutine exits while still locked so Go ends the thread too
+	}()
+	<-started
+	return t
+}
+
+// current returns whether the caller is running on the thread
+func (t *osThread) current() bool {
+	return threadID() == t.id
+}
+
+// run calls `fn` on the thread and waits for it to return. If `fn` panics, the panic is passed on to the caller
+func (t *osThread) run(fn func()) {
+	done := make(chan struct{})
+	var panicked interface{}
+	returned := false
+	t.calls <- func() {
+		defer func() {
+			if !returned {
+				panicked = recover()
+			}
+			close(done)
+		}()
+		fn()
+		returned = true
+	}
+	<-done
+	if !returned {
+		panic(panicked)
+	}
+}
+
+// stop ends the thread once it finishes what it is running
+func (t *osThread) stop() {
+	close(t.calls)
+}
+
+// onThread runs `fn` on the VM's thread and returns true if `Config.PinToThread` is set and the caller isn't on the thread already. Methods that use the VM start by calling themselves through it, so they run on the thread:
+//
+//	if vm.onThread(func() { err = vm.InterpretString(module, source) }) {
+//		return err
+//	}
+func (vm *VM) onThread(fn func()) bool {
+	if vm.thread == nil || vm.thread.current() {
+		return false
+	}
+	vm.thread.run(fn)
+	return true
+}
+
+// Do calls `fn` with the VM. If `Config.PinToThread` is set, `fn` runs on the VM's thread like the VM's own methods and handles do, so several of them can be used without switching threads for each one. Otherwise `fn` is just called
+func (vm *VM) Do(fn func(vm *VM) error) (err error) {
+	if vm.onThread(func() { err = fn(vm) }) {
+		return err
+	}
+	return fn(vm)
+}
+
+// onThread runs `fn` on the thread the handle's VM is pinned to (see `VM.onThread`)
+func (h *Handle) onThread(fn func()) bool {
+	return h != nil && h.vm != nil && h.vm.onThread(fn)
+}
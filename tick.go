@@ -0,0 +1,81 @@
+package wren
+
+// Actor is a script object or class whose `update(dt)` method is called by every `VM.Tick`
+type Actor struct {
+	vm     *VM
+	update *CallHandle
+}
+
+// AddActor registers `value` to be updated by `Tick`. It can be a class handle, in which case its `static update(dt)` method is called, or any other object, whose `update(dt)` method is called. The VM keeps its own handle, so `value` can be freed afterwards
+func (vm *VM) AddActor(value interface{}) (actor *Actor, err error) {
+	if vm.onThread(func() { actor, err = vm.AddActor(value) }) {
+		return actor, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
+	}
+	handle, ok := value.(freeableHandle)
+	if !ok {
+		return nil, &InvalidValue{Value: value}
+	}
+	if handle.Handle().vm != vm {
+		return nil, &NonMatchingVM{}
+	}
+	update, err := handle.Handle().Func("update(_)")
+	if err != nil {
+		return nil, err
+	}
+	actor = &Actor{vm: vm, update: update}
+	vm.actors = append(vm.actors, actor)
+	return actor, nil
+}
+
+// RegisterActor registers the variable `name` from `module` (usually a class) to be updated by `Tick`
+func (vm *VM) RegisterActor(module, name string) (*Actor, error) {
+	value, err := vm.GetVariable(module, name)
+	if err != nil {
+		return nil, err
+	}
+	defer vm.FreeAll(value)
+	return vm.AddActor(value)
+}
+
+// Actors returns how many actors are updated by `Tick`
+func (vm *VM) Actors() int {
+	return len(vm.actors)
+}
+
+// Remove stops the actor from being updated. It can be called while a tick is updating actors, in which case actors that were not updated yet are still updated in this tick unless they were removed
+func (a *Actor) Remove() {
+	if a.update == nil {
+		return
+	}
+	for i, actor := range a.vm.actors {
+		if actor == a {
+			a.vm.actors = append(a.vm.actors[:i:i], a.vm.actors[i+1:]...)
+			break
+		}
+	}
+	a.update.Free()
+	a.update = nil
+}
+
+// Tick advances every script by one frame for programs like games that run their own loop. It does one `Poll` (so timers that finished and other queued work are handled first) and then calls `update(dt)` on every actor in the order they were added, passing `dt`, the time since the last frame (usually in seconds). If an actor aborts, the other actors are still updated and the first error is returned
+func (vm *VM) Tick(dt float64) error {
+	if err := vm.Poll(); err != nil {
+		return err
+	}
+	var first error
+	for _, actor := range append([]*Actor(nil), vm.actors...) {
+		if actor.update == nil {
+			// removed by an actor updated before it
+			continue
+		}
+		result, err := actor.update.Call(dt)
+		vm.FreeAll(result)
+		if err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
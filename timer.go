@@ -0,0 +1,66 @@
+package wren
+
+import (
+	"sort"
+	"time"
+)
+
+// timer is a fiber waiting for `Timer.sleep` to finish
+type timer struct {
+	at    time.Time
+	fiber *FiberHandle
+}
+
+// NewTimerModule creates a module with a `Timer` class so scripts can wait without blocking the program. It is usually set as the "timer" module:
+//
+//	vm.SetModule("timer", wren.NewTimerModule())
+//
+// `Timer.sleep(milliseconds)` suspends the VM. `InterpretString` (or the call that was running) returns right away and the fiber that slept is resumed by `VM.Poll` or `VM.Run` once the time has passed, so a program can keep running its own loop while scripts wait.
+func NewTimerModule() *Module {
+	module := NewModule(ClassMap{
+		"Timer": NewClass(nil, nil, MethodMap{
+			"static schedule_(_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				milliseconds, ok := parameters[1].(float64)
+				if !ok {
+					return nil, &UnexpectedValue{Value: parameters[1]}
+				}
+				fiber, ok := parameters[2].(*FiberHandle)
+				if !ok {
+					return nil, &UnexpectedValue{Value: parameters[2]}
+				}
+				fiber, err := fiber.Copy()
+				if err != nil {
+					return nil, err
+				}
+				vm.schedule(time.Now().Add(time.Duration(milliseconds*float64(time.Millisecond))), fiber)
+				return Null, nil
+			},
+		}),
+	})
+	module.Source = `
+class Timer {
+	static sleep(milliseconds) {
+		if (!(milliseconds is Num)) Fiber.abort("Milliseconds must be a number.")
+		schedule_(milliseconds, Fiber.current)
+		Fiber.suspend()
+	}
+	foreign static schedule_(milliseconds, fiber)
+}
+`
+	return module
+}
+
+// schedule makes `Poll` resume `fiber` once `at` has passed. Timers that are due at the same time are resumed in the order they were scheduled
+func (vm *VM) schedule(at time.Time, fiber *FiberHandle) {
+	i := sort.Search(len(vm.timers), func(i int) bool {
+		return vm.timers[i].at.After(at)
+	})
+	vm.timers = append(vm.timers, timer{})
+	copy(vm.timers[i+1:], vm.timers[i:])
+	vm.timers[i] = timer{at: at, fiber: fiber}
+}
+
+// PendingTimers returns how many fibers are waiting for `Timer.sleep` to finish
+func (vm *VM) PendingTimers() int {
+	return len(vm.timers)
+}
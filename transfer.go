@@ -0,0 +1,83 @@
+package wren
+
+// CyclicValue is returned from `Transfer` if a list or map contains itself, since it can't be copied
+type CyclicValue struct{}
+
+func (err *CyclicValue) Error() string {
+	return "Cannot copy a list or map that contains itself"
+}
+
+// Transfer deep copies `value` from this VM into `target` and returns the copy, which belongs to `target`. Lists and maps are copied along with everything in them, and strings, numbers, bools and null are copied as they are. A list or map that appears more than once is copied each time. Values that only make sense in the VM they came from (such as foreign objects, fibers and functions) return `InvalidValue`. Maps can only be enumerated by calling into Wren, so while this VM is running (such as from inside of a foreign method) only values without maps can be transferred
+func (vm *VM) Transfer(value interface{}, target *VM) (copied interface{}, err error) {
+	if vm.onThread(func() { copied, err = vm.Transfer(value, target) }) {
+		return copied, err
+	}
+	if vm.vm == nil || target == nil || target.vm == nil {
+		return nil, &NilVMError{}
+	}
+	detached, err := vm.detach(value, nil, (*MapHandle).Keys)
+	if err != nil {
+		return nil, err
+	}
+	return target.Marshal(detached)
+}
+
+// detach copies a Wren value into Go slices and maps so it no longer depends on this VM. `parents` are the lists and maps being copied that contain `value`, and `keys` returns the keys of each map, in the order they are reached
+func (vm *VM) detach(value interface{}, parents []*Handle, keys func(*MapHandle) ([]interface{}, error)) (interface{}, error) {
+	var handle *Handle
+	switch value := value.(type) {
+	case nil, nullValue:
+		return nil, nil
+	case bool, float64, string:
+		return value, nil
+	case []byte:
+		return string(value), nil
+	case *ListHandle:
+		handle = value.handle
+	case *MapHandle:
+		handle = value.handle
+	default:
+		return nil, &InvalidValue{Value: value}
+	}
+	if handle.vm != vm {
+		return nil, &NonMatchingVM{}
+	}
+	for _, parent := range parents {
+		if parent.Same(handle) {
+			return nil, &CyclicValue{}
+		}
+	}
+	parents = append(parents, handle)
+	if list, ok := value.(*ListHandle); ok {
+		elements, err := list.ToSlice(false)
+		if err != nil {
+			return nil, err
+		}
+		defer vm.FreeAll(elements...)
+		copied := make([]interface{}, len(elements))
+		for i, element := range elements {
+			if copied[i], err = vm.detach(element, parents, keys); err != nil {
+				return nil, err
+			}
+		}
+		return copied, nil
+	}
+	m := value.(*MapHandle)
+	mapKeys, err := keys(m)
+	if err != nil {
+		return nil, err
+	}
+	defer vm.FreeAll(mapKeys...)
+	copied := make(map[interface{}]interface{}, len(mapKeys))
+	for _, key := range mapKeys {
+		element, _, err := m.GetOK(key)
+		if err == nil {
+			copied[key], err = vm.detach(element, parents, keys)
+			vm.FreeAll(element)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return copied, nil
+}
@@ -0,0 +1,189 @@
+package wren
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ArityMismatch is returned from the foreign methods created by `Method0` through `Method4` if they are called with the wrong amount of arguments
+type ArityMismatch struct {
+	Expected, Got int
+}
+
+func (err *ArityMismatch) Error() string {
+	return fmt.Sprintf("Expected %v arguments but got %v", err.Expected, err.Got)
+}
+
+func checkArity(parameters []interface{}, expected int) error {
+	if len(parameters)-1 != expected {
+		return &ArityMismatch{Expected: expected, Got: len(parameters) - 1}
+	}
+	return nil
+}
+
+// decodeArg converts parameters[i] into `T` the same way `Unmarshal` does. If `T` is an empty interface, the parameter is passed as it is
+func decodeArg[T any](vm *VM, parameters []interface{}, i int) (T, error) {
+	var value T
+	v := reflect.ValueOf(&value).Elem()
+	if v.Kind() == reflect.Interface && v.NumMethod() == 0 {
+		if parameters[i] != nil {
+			v.Set(reflect.ValueOf(parameters[i]))
+		}
+		return value, nil
+	}
+	err := vm.unmarshal(parameters[i], v, fmt.Sprintf("argument %v", i))
+	return value, err
+}
+
+// Method0 creates a `ForeignMethodFn` that takes no arguments. The returned function aborts the fiber with `ArityMismatch` if it is called with any
+func Method0(fn func(vm *VM) (interface{}, error)) ForeignMethodFn {
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		if err := checkArity(parameters, 0); err != nil {
+			return nil, err
+		}
+		return fn(vm)
+	}
+}
+
+// Method1 creates a `ForeignMethodFn` whose argument is converted into `A` (see `Unmarshal`). The fiber is aborted with `ArityMismatch` or `UnmarshalTypeError` if the arguments don't match
+func Method1[A any](fn func(vm *VM, a A) (interface{}, error)) ForeignMethodFn {
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		if err := checkArity(parameters, 1); err != nil {
+			return nil, err
+		}
+		a, err := decodeArg[A](vm, parameters, 1)
+		if err != nil {
+			return nil, err
+		}
+		return fn(vm, a)
+	}
+}
+
+// Method2 is like `Method1` but for foreign methods with two arguments
+func Method2[A, B any](fn func(vm *VM, a A, b B) (interface{}, error)) ForeignMethodFn {
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		if err := checkArity(parameters, 2); err != nil {
+			return nil, err
+		}
+		a, err := decodeArg[A](vm, parameters, 1)
+		if err != nil {
+			return nil, err
+		}
+		b, err := decodeArg[B](vm, parameters, 2)
+		if err != nil {
+			return nil, err
+		}
+		return fn(vm, a, b)
+	}
+}
+
+// Method3 is like `Method1` but for foreign methods with three arguments
+func Method3[A, B, C any](fn func(vm *VM, a A, b B, c C) (interface{}, error)) ForeignMethodFn {
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		if err := checkArity(parameters, 3); err != nil {
+			return nil, err
+		}
+		a, err := decodeArg[A](vm, parameters, 1)
+		if err != nil {
+			return nil, err
+		}
+		b, err := decodeArg[B](vm, parameters, 2)
+		if err != nil {
+			return nil, err
+		}
+		c, err := decodeArg[C](vm, parameters, 3)
+		if err != nil {
+			return nil, err
+		}
+		return fn(vm, a, b, c)
+	}
+}
+
+// Method4 is like `Method1` but for foreign methods with four arguments
+func Method4[A, B, C, D any](fn func(vm *VM, a A, b B, c C, d D) (interface{}, error)) ForeignMethodFn {
+	return func(vm *VM, parameters []interface{}) (interface{}, error) {
+		if err := checkArity(parameters, 4); err != nil {
+			return nil, err
+		}
+		a, err := decodeArg[A](vm, parameters, 1)
+		if err != nil {
+			return nil, err
+		}
+		b, err := decodeArg[B](vm, parameters, 2)
+		if err != nil {
+			return nil, err
+		}
+		c, err := decodeArg[C](vm, parameters, 3)
+		if err != nil {
+			return nil, err
+		}
+		d, err := decodeArg[D](vm, parameters, 4)
+		if err != nil {
+			return nil, err
+		}
+		return fn(vm, a, b, c, d)
+	}
+}
+
+// TypeMismatch is returned from `CallAs` and `VarAs` if a value from Wren isn't the type that was asked for. `Got` is nil if the value was null
+type TypeMismatch struct {
+	Expected, Got reflect.Type
+}
+
+func (err *TypeMismatch) Error() string {
+	got := "null"
+	if err.Got != nil {
+		got = err.Got.String()
+	}
+	return fmt.Sprintf("Expected a value of type %v but got %v", err.Expected, got)
+}
+
+// valueAs asserts that `value` is a `T`, freeing it if it isn't
+func valueAs[T any](vm *VM, value interface{}) (T, error) {
+	result, ok := value.(T)
+	if !ok {
+		vm.FreeAll(value)
+		return result, &TypeMismatch{Expected: reflect.TypeOf(&result).Elem(), Got: reflect.TypeOf(value)}
+	}
+	return result, nil
+}
+
+// CallAs calls `handle` with `parameters` and returns its result as a `T`, such as `CallAs[float64](add, 1, 2)`. If the result is a different type, `TypeMismatch` is returned
+func CallAs[T any](handle *CallHandle, parameters ...interface{}) (T, error) {
+	value, err := handle.Call(parameters...)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return valueAs[T](handle.handle.vm, value)
+}
+
+// VarAs gets the variable `name` from `module` as a `T`, such as `VarAs[*ListHandle](vm, "main", "items")`. If the variable is a different type, `TypeMismatch` is returned
+func VarAs[T any](vm *VM, module, name string) (T, error) {
+	value, err := vm.GetVariable(module, name)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return valueAs[T](vm, value)
+}
+
+// ForeignAs gets the Go value that a foreign object holds as a `T`. `TypeMismatch` is returned if it holds a value of another type
+func ForeignAs[T any](h *ForeignHandle) (T, error) {
+	var result T
+	value, err := h.Get()
+	if err != nil {
+		return result, err
+	}
+	result, ok := value.(T)
+	if !ok {
+		return result, &TypeMismatch{Expected: reflect.TypeOf(&result).Elem(), Got: reflect.TypeOf(value)}
+	}
+	return result, nil
+}
+
+// Is reports whether the foreign object holds a Go value of the same type as `value`, such as `h.Is((*Point)(nil))`
+func (h *ForeignHandle) Is(value interface{}) bool {
+	stored, err := h.Get()
+	return err == nil && stored != nil && reflect.TypeOf(stored) == reflect.TypeOf(value)
+}
@@ -14,40 +14,98 @@ extern WrenForeignClassMethods bindForeignClassFn(WrenVM*, char*, char*);
 extern void foreignFinalizerFn(void*);
 extern void invalidConstructor(WrenVM*);
 extern void loadModuleCompleteFn(WrenVM*, char*, WrenLoadModuleResult);
+extern void reallocateFn(void*, size_t, size_t);
+extern char* checkpointFn(void*);
 */
 import "C"
 import (
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"os"
+	"path"
+	"path/filepath"
 	"reflect"
-	"sync"
+	"runtime/cgo"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
 	"unsafe"
 )
 
 // VM is an instance of Wren's virtual machine
 type VM struct {
-	vm        *C.WrenVM
-	Config    *Config
-	handles   map[*C.WrenHandle]*Handle
-	bindMap   []ForeignMethodFn
-	moduleMap ModuleMap
-	running   bool
+	vm         *C.WrenVM
+	Config     *Config
+	handles    map[*C.WrenHandle]*Handle
+	bindMap    []ForeignMethodFn
+	moduleMap  ModuleMap
+	running    bool
+	arena      arena
+	input      inputState
+	calls      map[staticCall]*CallHandle
+	slotTop    int
+	interrupts interruptState
+	heap       *heap
+	reported   []error
+	released   releaseQueue
+	// stops the watchdog for `Config.MaxExecutionTime`
+	stopWatchdog func()
+	stats        Stats
+	id           int64
+	// text printed after the last newline when `Config.LineWriter` is set
+	partialLine string
+	// the ID of the last handle made, which is never reset so handles from before `Reset` can't match new ones
+	lastHandle uint64
+	// call handle for `toString`, made the first time a handle is printed
+	toStringFn *Handle
+	// the Go values of foreign objects by the ID written into their bytes
+	foreigns    map[uint64]foreignInstance
+	lastForeign uint64
+	// the foreign methods of every module set in the VM, so they can be found quickly when Wren binds them
+	methods map[methodKey]ForeignMethodFn
+	// where in `bindMap` each method that Wren bound is
+	bound map[methodKey]int
+	// lets callbacks from C find the VM. It is kept in the VM's heap and foreign objects
+	self cgo.Handle
+	// fibers waiting for `Timer.sleep` to finish, soonest first
+	timers []timer
+	// fibers waiting to receive from Go channels
+	receivers []receiver
+	// fibers waiting to send to Go channels
+	senders []sender
+	// calls made while the VM was running, for `Config.QueueReentrantCalls` and `Defer`
+	queued []func() error
+	// the thread the VM runs on if `Config.PinToThread` is set
+	thread *osThread
+	// work for the event loop, which can be queued from other goroutines
+	tasks *taskQueue
+	// objects updated by `Tick`, in the order they were added
+	actors []*Actor
+	// how many modules scripts imported, for `SandboxPolicy.MaxModules`
+	imported int
 }
 
 var (
-	vmMap         map[*C.WrenVM]*VM = make(map[*C.WrenVM]*VM)
-	vmMapMux      sync.RWMutex
-	foreignMap    map[unsafe.Pointer]foreignInstance = make(map[unsafe.Pointer]foreignInstance)
-	foreignMapMux sync.RWMutex
+	// lastID is the ID of the most recently created VM
+	lastID int64
 	// DefaultOutput is where Wren will print to if a VM's config doesn't specify its own output (Set this to nil to disable output)
 	DefaultOutput io.Writer = os.Stdout
 	// DefaultError is where Wren will send error messages to if a VM's config doesn't specify its own place for outputting errors (Set this to nil to disable output)
 	DefaultError io.Writer = os.Stderr
-	// DefaultModuleLoader allows Wren to import modules by loading files relative to the current directory (Set this to nil to disable importing or file access)
+	// DefaultInput is where scripts using the module from `NewStdinModule` read from if a VM's config doesn't specify its own input (Set this to nil to disable input)
+	DefaultInput io.Reader = os.Stdin
+	// DefaultModuleResolver resolves imports that start with "./" or "../" relative to the module importing them (Set this to nil to pass module names to the loader unchanged)
+	DefaultModuleResolver ResolveModuleFn = ResolveRelative
+	// DefaultModuleLoader allows Wren to import modules by loading the file named by the module relative to the current directory. Names that would leave the current directory, like "../secret" or absolute paths, aren't loaded (Set this to nil to disable importing or file access, or set `Config.LoadModuleFn` to a `SearchPathLoader` or `WrenPathLoader` to search more directories)
 	DefaultModuleLoader LoadModuleFn = func(vm *VM, name string) (string, bool) {
-		if data, err := ioutil.ReadFile(name); err == nil {
+		file := filepath.Clean(filepath.FromSlash(name))
+		if filepath.IsAbs(file) || !insideDir(".", file) {
+			return "", false
+		}
+		if data, err := ioutil.ReadFile(file); err == nil {
 			return string(data), true
 		}
 		return "", false
@@ -56,6 +114,26 @@ var (
 
 // NewVM creates a new instance of Wren's virtual machine with blank configurations
 func NewVM() *VM {
+	return newVM(&Config{})
+}
+
+func newVM(cfg *Config) *VM {
+	heap := newHeap()
+	vm := VM{heap: heap, handles: make(map[*C.WrenHandle]*Handle), bindMap: make([]ForeignMethodFn, 0), moduleMap: make(ModuleMap), methods: make(map[methodKey]ForeignMethodFn), bound: make(map[methodKey]int), foreigns: make(map[uint64]foreignInstance), tasks: newTaskQueue(), Config: cfg, id: atomic.AddInt64(&lastID, 1)}
+	vm.self = cgo.NewHandle(&vm)
+	heap.setOwner(vm.self)
+	if cfg.PinToThread {
+		vm.thread = startThread()
+	}
+	vm.open()
+	return &vm
+}
+
+// open creates the Wren VM that `vm` wraps
+func (vm *VM) open() {
+	if vm.onThread(vm.open) {
+		return
+	}
 	var config C.WrenConfiguration
 	C.wrenInitConfiguration(&config)
 	config.writeFn = C.WrenWriteFn(C.writeFn)
@@ -64,54 +142,142 @@ func NewVM() *VM {
 	config.loadModuleFn = C.WrenLoadModuleFn(C.moduleLoaderFn)
 	config.bindForeignMethodFn = C.WrenBindForeignMethodFn(C.bindForeignMethodFn)
 	config.bindForeignClassFn = C.WrenBindForeignClassFn(C.bindForeignClassFn)
-	vm := VM{vm: C.wrenNewVM(&config), handles: make(map[*C.WrenHandle]*Handle), bindMap: make([]ForeignMethodFn, 0), moduleMap: make(ModuleMap), Config: &Config{}}
-	vmMapMux.Lock()
-	defer vmMapMux.Unlock()
-	vmMap[vm.vm] = &vm
-	return &vm
+	vm.heap.configure(&config, vm.Config.MaxHeapBytes, vm.Config.ReallocateFn != nil)
+	vm.vm = C.wrenNewVM(&config)
+}
+
+// ID returns a number that identifies the VM in logs. Every VM created by the process gets a different ID
+func (vm *VM) ID() int64 {
+	return vm.id
 }
 
 // NewVM creates a new instance of Wren's virtual machine by cloning the config passed to it
 func (cfg *Config) NewVM() *VM {
-	vm := NewVM()
-	vm.Config = cfg.Clone()
+	vm := newVM(cfg.Clone())
+	vm.runPreludes()
 	return vm
 }
 
+// runPreludes interprets the config's `Preludes`, sending a `PreludeError` to `ErrorFn` if one fails
+func (vm *VM) runPreludes() error {
+	if vm.Config == nil {
+		return nil
+	}
+	for _, prelude := range vm.Config.Preludes {
+		if err := vm.InterpretString(prelude.Module, prelude.Source); err != nil {
+			err = &PreludeError{Module: prelude.Module, Err: err}
+			vm.sendError(err)
+			return err
+		}
+	}
+	return nil
+}
+
 // Free destroys the wren virtual machine and frees all handles tied to it. The VM should be freed when no longer in use. The VM should not be used after it has been freed
 func (vm *VM) Free() {
+	if vm.onThread(vm.Free) {
+		return
+	}
+	vm.close()
+	vm.handles = nil
+	if vm.self != 0 {
+		vm.self.Delete()
+		vm.self = 0
+	}
+	if vm.heap != nil {
+		vm.arena.free()
+		vm.heap.free()
+		vm.heap = nil
+	}
+	if vm.thread != nil {
+		vm.thread.stop()
+		vm.thread = nil
+	}
+}
+
+// close frees every handle and destroys the Wren VM that `vm` wraps
+func (vm *VM) close() {
 	if vm.handles != nil {
 		for _, handle := range vm.handles {
 			handle.Free()
 		}
-		vm.handles = nil
 	}
+	vm.calls = nil
+	vm.toStringFn = nil
+	vm.timers = nil
+	vm.receivers = nil
+	vm.senders = nil
+	vm.tasks.reset()
+	vm.actors = nil
+	vm.imported = 0
+	vm.queued = nil
 	if vm.vm != nil {
-		vmMapMux.Lock()
-		defer vmMapMux.Unlock()
-		if _, ok := vmMap[vm.vm]; ok {
-			delete(vmMap, vm.vm)
-		}
+		vm.releaseAll()
 		C.wrenFreeVM(vm.vm)
 		vm.vm = nil
 	}
 }
 
-// SetModule sets a foreign module for wren to import from (If a vm already imported classes and methods from this module already, changing it again won't set the previously imported values)
+// Reset destroys everything the VM's scripts have created, including every handle and every module that was interpreted, and starts over with a new Wren VM. The config and modules set with `SetModule` are kept and `Config.Preludes` are interpreted again. Handles from before the reset should not be used afterwards. This cannot be used while the VM is running
+func (vm *VM) Reset() (err error) {
+	if vm.onThread(func() { err = vm.Reset() }) {
+		return err
+	}
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	if vm.running {
+		return &RunningVMError{}
+	}
+	vm.close()
+	vm.handles = make(map[*C.WrenHandle]*Handle)
+	// Wren binds foreign methods again when their modules are imported
+	vm.bindMap = vm.bindMap[:0]
+	vm.bound = make(map[methodKey]int)
+	vm.slotTop = 0
+	vm.reported = nil
+	vm.clearInterrupt()
+	vm.heap.peak = vm.heap.used
+	vm.open()
+	return vm.runPreludes()
+}
+
+// SetModule sets a foreign module for wren to import from (If a vm already imported classes and methods from this module already, changing it again won't set the previously imported values). If any of its signatures are malformed, `InvalidSignatures` is sent to `ErrorFn`
 func (vm *VM) SetModule(name string, module *Module) {
-	vm.moduleMap[name] = module.Clone()
+	if vm.onThread(func() { vm.SetModule(name, module) }) {
+		return
+	}
+	if err := module.Validate(); err != nil {
+		vm.sendError(err)
+	}
+	vm.setModule(name, module)
 }
 
 // Merge combine all non nil values from `moduleMap` to the vm's own module map (If a vm already imported classes and methods from any module already, changing it again won't set the previously imported values)
 func (vm *VM) Merge(moduleMap ModuleMap) {
+	if vm.onThread(func() { vm.Merge(moduleMap) }) {
+		return
+	}
 	vm.moduleMap.Merge(moduleMap)
+	for name := range moduleMap {
+		vm.indexModule(name)
+	}
 }
 
-// ResultCompileError is returned from `InterpretString` or `InterpretFile` if there were problems compiling the Wren source code
-type ResultCompileError struct{}
+// ResultCompileError is returned from `InterpretString` or `InterpretFile` if there were problems compiling the Wren source code. `Diagnostics` holds every `CompileError` Wren reported while compiling (they are still sent to `ErrorFn` too)
+type ResultCompileError struct {
+	Diagnostics []*CompileError
+}
 
 func (err *ResultCompileError) Error() string {
-	return "Wren Error during compilation"
+	if len(err.Diagnostics) == 0 {
+		return "Wren Error during compilation"
+	}
+	messages := make([]string, len(err.Diagnostics))
+	for i, diagnostic := range err.Diagnostics {
+		messages[i] = diagnostic.Error()
+	}
+	return "Wren Error during compilation: " + strings.Join(messages, "; ")
 }
 
 // ResultRuntimeError is returned from `InterpretString`, `InterpretFile`, or `Call` if there was a problem during script execution
@@ -121,6 +287,35 @@ func (err *ResultRuntimeError) Error() string {
 	return "Wren Error during runtime"
 }
 
+// StackFrame is one line of a runtime error's stack trace. `Function` is the signature of the method that was running (such as "update(_)") or "(script)" for a module's top level code
+type StackFrame struct {
+	Module   string
+	Line     int
+	Function string
+}
+
+// RuntimeErrorWithTrace is returned instead of `ResultRuntimeError` if Wren reported what went wrong. It holds the `RuntimeError` message and the `StackTrace`s that followed it (which are still sent to `ErrorFn` too), with the innermost frame first. It unwraps to `ResultRuntimeError` so `errors.As` still finds that
+type RuntimeErrorWithTrace struct {
+	Message string
+	// What the fiber was aborted with (see `RuntimeError.Value`)
+	Value interface{}
+	Trace []StackFrame
+	// Compile errors reported while running, such as from `Meta.eval` or importing a module that didn't compile
+	Diagnostics []*CompileError
+}
+
+func (err *RuntimeErrorWithTrace) Error() string {
+	var trace strings.Builder
+	for _, frame := range err.Trace {
+		fmt.Fprintf(&trace, "\n\tat %v [%v line %v]", frame.Function, frame.Module, frame.Line)
+	}
+	return "Wren Error during runtime: " + err.Message + trace.String()
+}
+
+func (err *RuntimeErrorWithTrace) Unwrap() error {
+	return &ResultRuntimeError{}
+}
+
 // NilVMError is returned if there was an attempt to use a VM that was freed already
 type NilVMError struct{}
 
@@ -128,6 +323,46 @@ func (err *NilVMError) Error() string {
 	return "Wren VM is nil"
 }
 
+// attachReported adds the errors Wren sent to `ErrorFn` during the run to the error the run returned. Lists and maps that fibers were aborted with are converted into Go values and their handles freed
+func (vm *VM) attachReported(err error) error {
+	reported := vm.reported
+	vm.reported = nil
+	for _, e := range reported {
+		if e, ok := e.(*RuntimeError); ok {
+			e.value, _ = toGo(e.value)
+		}
+	}
+	switch err := err.(type) {
+	case *ResultCompileError:
+		for _, e := range reported {
+			if diagnostic, ok := e.(*CompileError); ok {
+				err.Diagnostics = append(err.Diagnostics, diagnostic)
+			}
+		}
+	case *ResultRuntimeError:
+		var (
+			traced      *RuntimeErrorWithTrace
+			diagnostics []*CompileError
+		)
+		for _, e := range reported {
+			switch e := e.(type) {
+			case *CompileError:
+				diagnostics = append(diagnostics, e)
+			case *RuntimeError:
+				traced = &RuntimeErrorWithTrace{Message: e.message, Value: e.value, Diagnostics: diagnostics}
+			case *StackTrace:
+				if traced != nil {
+					traced.Trace = append(traced.Trace, StackFrame{Module: e.module, Line: e.line, Function: e.message})
+				}
+			}
+		}
+		if traced != nil {
+			return traced
+		}
+	}
+	return err
+}
+
 func resultsToError(results C.WrenInterpretResult) error {
 	switch results {
 	case C.WREN_RESULT_SUCCESS:
@@ -142,56 +377,297 @@ func resultsToError(results C.WrenInterpretResult) error {
 }
 
 // InterpretString compiles and runs wren source code from `source`. the module name of the source can be set with `module`. This function should not be called if the VM is currently running.
-func (vm *VM) InterpretString(module, source string) error {
+func (vm *VM) InterpretString(module, source string) (err error) {
+	if vm.onThread(func() { err = vm.InterpretString(module, source) }) {
+		return err
+	}
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	if vm.running {
+		return vm.reenter(func() error {
+			return vm.InterpretString(module, source)
+		})
+	}
+	defer vm.runQueued()
+	defer vm.arena.release(vm.arena.mark())
+	cModule := vm.arena.cString(module)
+	cSource := vm.arena.cString(source)
+	count(&vm.stats.Interprets)
+	if hook := vm.Config.BeforeInterpret; hook != nil {
+		hook(vm, module)
+	}
+	vm.startRun()
+	err = vm.finishRun(resultsToError(C.wrenInterpret(vm.vm, cModule, cSource)))
+	if hook := vm.Config.AfterInterpret; hook != nil {
+		hook(vm, module, err)
+	}
+	return err
+}
+
+// VariableRedefined is returned from `InterpretMore` if the source declares a module variable that the module already has
+type VariableRedefined struct {
+	Module, Name string
+	Line         int
+}
+
+func (err *VariableRedefined) Error() string {
+	return fmt.Sprintf("[%v line %v] Module \"%v\" already defines variable \"%v\"", err.Module, err.Line, err.Module, err.Name)
+}
+
+// InterpretMore compiles and runs more wren source code in a module that may have already been interpreted, adding its declarations to the module (like a REPL would). If `source` declares a variable (using `var`, `class`, or `import ... for`) that the module already defines, `VariableRedefined` is returned and none of `source` is run. If the module does not exist yet, this behaves like `InterpretString`. This function should not be called if the VM is currently running.
+func (vm *VM) InterpretMore(module, source string) (err error) {
+	if vm.onThread(func() { err = vm.InterpretMore(module, source) }) {
+		return err
+	}
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	if vm.running {
+		return &RunningVMError{}
+	}
+	if vm.HasModule(module) {
+		for _, decl := range topLevelDeclarations(source) {
+			if vm.HasVariable(module, decl.name) {
+				return &VariableRedefined{Module: module, Name: decl.name, Line: decl.line}
+			}
+		}
+	}
+	return vm.InterpretString(module, source)
+}
+
+// InterpretStringWithArgs is like `InterpretString` but first sets the module variable `Args` to a list of `args`, like the arguments of a command line program. If the module already has `Args` (such as from an earlier call), its contents are replaced
+func (vm *VM) InterpretStringWithArgs(module, source string, args []string) (err error) {
+	if vm.onThread(func() { err = vm.InterpretStringWithArgs(module, source, args) }) {
+		return err
+	}
 	if vm.vm == nil {
 		return &NilVMError{}
 	}
 	if vm.running {
 		return &RunningVMError{}
 	}
-	cModule := C.CString(module)
-	cSource := C.CString(source)
-	defer func() {
-		C.free(unsafe.Pointer(cModule))
-		C.free(unsafe.Pointer(cSource))
-	}()
-	vm.running = true
-	results := C.wrenInterpret(vm.vm, cModule, cSource)
-	vm.running = false
-	return resultsToError(results)
+	if !vm.HasVariable(module, "Args") {
+		if err := vm.InterpretString(module, "var Args = []"); err != nil {
+			return err
+		}
+	}
+	list, err := VarAs[*ListHandle](vm, module, "Args")
+	if err != nil {
+		return err
+	}
+	defer list.Free()
+	if err := list.Clear(); err != nil {
+		return err
+	}
+	for _, arg := range args {
+		if err := list.Insert(arg); err != nil {
+			return err
+		}
+	}
+	return vm.InterpretString(module, source)
+}
+
+// InterpretFile compiles and runs wren source code from the given file. the module name would be set to the `fileName`, This function should not be called if the VM is currently running. A leading shebang line (starting with "#!/", such as "#!/usr/bin/env wrengo") is skipped.
+func (vm *VM) InterpretFile(fileName string) (err error) {
+	if vm.onThread(func() { err = vm.InterpretFile(fileName) }) {
+		return err
+	}
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return vm.InterpretReader(fileName, file)
+}
+
+// InterpretFileFS compiles and runs wren source code from the file at `path` inside `fsys`. the module name would be set to `path`, This function should not be called if the VM is currently running. A leading shebang line (starting with "#!/", such as "#!/usr/bin/env wrengo") is skipped.
+func (vm *VM) InterpretFileFS(fsys fs.FS, path string) (err error) {
+	if vm.onThread(func() { err = vm.InterpretFileFS(fsys, path) }) {
+		return err
+	}
+	if vm.vm == nil {
+		return &NilVMError{}
+	}
+	file, err := fsys.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return vm.InterpretReader(path, file)
+}
+
+// SourceTooLarge is returned if source code is longer than the config's `MaxSourceBytes`
+type SourceTooLarge struct {
+	Module string
+	Max    int64
+}
+
+func (err *SourceTooLarge) Error() string {
+	return fmt.Sprintf("Source of module \"%v\" is longer than %v bytes", err.Module, err.Max)
 }
 
-// InterpretFile compiles and runs wren source code from the given file. the module name would be set to the `fileName`, This function should not be called if the VM is currently running.
-func (vm *VM) InterpretFile(fileName string) error {
+// InterpretReader compiles and runs wren source code read from `r` until EOF, such as from a network stream or an archive. If the config's `MaxSourceBytes` is set, reading stops after that many bytes and `SourceTooLarge` is returned without running anything. A leading shebang line (starting with "#!/", such as "#!/usr/bin/env wrengo") is skipped. This function should not be called if the VM is currently running.
+func (vm *VM) InterpretReader(module string, r io.Reader) (err error) {
+	if vm.onThread(func() { err = vm.InterpretReader(module, r) }) {
+		return err
+	}
 	if vm.vm == nil {
 		return &NilVMError{}
 	}
-	data, err := ioutil.ReadFile(fileName)
+	if vm.running {
+		return &RunningVMError{}
+	}
+	var max int64
+	if vm.Config != nil {
+		max = vm.Config.MaxSourceBytes
+	}
+	if max > 0 {
+		r = io.LimitReader(r, max+1)
+	}
+	data, err := ioutil.ReadAll(r)
 	if err != nil {
 		return err
 	}
-	return vm.InterpretString(fileName, string(data))
+	if max > 0 && int64(len(data)) > max {
+		return &SourceTooLarge{Module: module, Max: max}
+	}
+	return vm.InterpretString(module, string(data))
+}
+
+// moduleFiles returns the files that may hold the module `name`. Names with an extension are used as they are, otherwise "name.wren" is tried before the package file "name/module.wren"
+func moduleFiles(name string) []string {
+	if path.Ext(name) != "" {
+		return []string{name}
+	}
+	return []string{name + ".wren", path.Join(name, "module.wren")}
+}
+
+// SearchPathLoader creates a `LoadModuleFn` that looks for modules in each of `dirs` in order. Like `FSModuleLoader`, `import "foo/bar"` loads "foo/bar.wren" or, if that doesn't exist, "foo/bar/module.wren". Names that would leave a directory like "../secret" are not loaded from it
+func SearchPathLoader(dirs ...string) LoadModuleFn {
+	return func(vm *VM, name string) (string, bool) {
+		for _, dir := range dirs {
+			for _, file := range moduleFiles(name) {
+				file := filepath.Join(dir, filepath.FromSlash(file))
+				if !insideDir(dir, file) {
+					continue
+				}
+				data, err := ioutil.ReadFile(file)
+				if err == nil {
+					return string(data), true
+				}
+			}
+		}
+		return "", false
+	}
+}
+
+// WrenPathLoader creates a `SearchPathLoader` that looks for modules in the current directory and then in the directories listed in the WRENPATH environment variable
+func WrenPathLoader() LoadModuleFn {
+	return SearchPathLoader(append([]string{"."}, filepath.SplitList(os.Getenv("WRENPATH"))...)...)
+}
+
+// FSModuleLoader creates a `LoadModuleFn` that loads modules from files inside `fsys`, such as an `embed.FS` or a zip archive. Module names are looked up relative to `root` (use "." for the top of `fsys`) and ".wren" is added to names without an extension (or "/module.wren" for packages). Names that would leave `root` like "../secret" are not loaded
+func FSModuleLoader(fsys fs.FS, root string) LoadModuleFn {
+	return func(vm *VM, name string) (string, bool) {
+		name = path.Clean(name)
+		if name == ".." || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+			return "", false
+		}
+		for _, file := range moduleFiles(name) {
+			file = path.Join(root, file)
+			if !fs.ValidPath(file) {
+				return "", false
+			}
+			if data, err := fs.ReadFile(fsys, file); err == nil {
+				return string(data), true
+			}
+		}
+		return "", false
+	}
 }
 
 // IsRunning returns true if the current VM is running (Whether `InterpretString`, `InterpretFile`, and any `CallHandle`s have been called on this VM)
-func (vm *VM) IsRunning() bool {
+func (vm *VM) IsRunning() (running bool) {
+	if vm.onThread(func() { running = vm.IsRunning() }) {
+		return running
+	}
 	return vm.running
 }
 
-type RunningVMError struct {}
+// RunningVMError is returned if the VM is used in a way that needs it to be stopped while it is running, such as interpreting source or calling into Wren from a foreign method. Wren can't be re-entered so this would corrupt the VM. It unwraps to `ReentrantCallError`. `Config.QueueReentrantCalls` makes `InterpretString` and `CallHandle.Call` wait until the VM stops instead
+type RunningVMError struct{}
 
-func (err *RunningVMError)Error() string {
+func (err *RunningVMError) Error() string {
 	return "VM is already running"
 }
+
+// Unwrap returns `ReentrantCallError`, since the VM is only ever called while running by something it is running (or by another goroutine, which isn't safe either)
+func (err *RunningVMError) Unwrap() error {
+	return &ReentrantCallError{}
+}
+
+// reenter handles a call made while the VM is running. If `Config.QueueReentrantCalls` is set, `call` is queued to run once the VM stops and nil is returned, otherwise `RunningVMError` is returned
+func (vm *VM) reenter(call func() error) error {
+	if vm.Config == nil || !vm.Config.QueueReentrantCalls {
+		return &RunningVMError{}
+	}
+	vm.queued = append(vm.queued, call)
+	return nil
+}
+
+// Defer calls `fn` once the VM stops running, right after the interpretation or call that is running returns, so foreign methods can call back into Wren without re-entering it. Callbacks run in the order they were deferred, before the interpretation or call returns to Go, and they can call into Wren and defer more callbacks. If the VM isn't running, `fn` is called right away. Errors `fn` returns are sent to `ErrorFn`
+func (vm *VM) Defer(fn func(vm *VM) error) {
+	if vm.onThread(func() { vm.Defer(fn) }) {
+		return
+	}
+	vm.queued = append(vm.queued, func() error {
+		return fn(vm)
+	})
+	if !vm.running {
+		vm.runQueued()
+	}
+}
+
+// runQueued runs the calls that were queued while the VM was running, in the order they were made. Errors that Wren didn't already send to `ErrorFn` are sent there, since there is nothing else to return them to
+func (vm *VM) runQueued() {
+	for len(vm.queued) > 0 && !vm.running && vm.vm != nil {
+		call := vm.queued[0]
+		vm.queued = vm.queued[1:]
+		err := call()
+		var compileError *ResultCompileError
+		var runtimeError *ResultRuntimeError
+		if err != nil && !errors.As(err, &compileError) && !errors.As(err, &runtimeError) {
+			vm.sendError(err)
+		}
+	}
+}
+
 // Handle is a generic handle from wren
 type Handle struct {
 	handle *C.WrenHandle
 	vm     *VM
+	// tells this handle apart from ones the VM made before with the same pointer (see `live`)
+	id uint64
 }
 
 func (vm *VM) createHandle(handle *C.WrenHandle) *Handle {
-	h := &Handle{handle: handle, vm: vm}
-	vm.handles[h.handle] = h
+	return vm.newHandle(handle, vm.Config.AutoFreeHandles)
+}
+
+// newHandle wraps `handle`. If `tracked` is set, it is released once the `Handle` is garbage collected like with `Config.AutoFreeHandles`
+func (vm *VM) newHandle(handle *C.WrenHandle, tracked bool) *Handle {
+	vm.releasePending()
+	vm.lastHandle++
+	h := &Handle{handle: handle, vm: vm, id: vm.lastHandle}
+	if tracked {
+		vm.track(h)
+	} else {
+		vm.handles[h.handle] = h
+	}
 	return h
 }
 
@@ -205,30 +681,75 @@ func (h *Handle) VM() *VM {
 	return h.vm
 }
 
-// Free releases the handle tied to it. The handle should be freed when no longer in use. The handle should not be used after it has been freed
+// Free releases the handle tied to it. The handle should be freed when no longer in use. The handle should not be used after it has been freed, and using it returns `NilHandleError`. Freeing a handle again, or freeing one after its VM was reset or freed, does nothing
 func (h *Handle) Free() {
-	if h.handle != nil {
-		C.wrenReleaseHandle(h.vm.vm, h.handle)
-		h.handle = nil
+	if h.onThread(h.Free) {
+		return
 	}
-	if _, ok := h.vm.handles[h.handle]; ok {
+	if h.live() {
 		delete(h.vm.handles, h.handle)
+		h.vm.untrack(h)
+		if h.vm.vm != nil {
+			C.wrenReleaseHandle(h.vm.vm, h.handle)
+		}
+	}
+	h.handle = nil
+}
+
+// live returns whether the Wren handle of `h` is still held. Once a handle is freed its pointer may be reused by a new handle, so handles are checked by the ID they were made with too. Handles copied by value (like `copy := *h`) share the ID, so when one of them is freed the others stop being live instead of releasing the pointer again. Handles from before the VM was reset or freed are never live
+func (h *Handle) live() bool {
+	if h == nil || h.handle == nil || h.vm == nil {
+		return false
+	}
+	if owner, ok := h.vm.handles[h.handle]; ok {
+		return owner.id == h.id
+	}
+	id, ok := h.vm.released.live[h.handle]
+	return ok && id == h.id
+}
+
+// String returns what calling `toString` on the object gives in Wren. Wren can't be called into while the VM is running, so "<object>" is returned instead
+func (h *Handle) String() (s string) {
+	if h.onThread(func() { s = h.String() }) {
+		return s
 	}
+	return h.vm.toString(h, "<object>")
+}
+
+// toString calls `toString` on `receiver` with a call handle that is only made once for each VM. If the VM is running or the call fails, `placeholder` is returned instead
+func (vm *VM) toString(receiver *Handle, placeholder string) string {
+	if vm.vm == nil || vm.running || !receiver.live() {
+		return placeholder
+	}
+	if vm.toStringFn == nil {
+		defer vm.arena.release(vm.arena.mark())
+		vm.toStringFn = vm.createHandle(C.wrenMakeCallHandle(vm.vm, vm.arena.cString("toString")))
+	}
+	value, err := (&CallHandle{receiver: receiver, handle: vm.toStringFn, signature: "toString"}).Call()
+	str, ok := value.(string)
+	if err != nil || !ok {
+		vm.FreeAll(value)
+		return placeholder
+	}
+	return str
 }
 
 // Func creates a callable handle from the wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
-func (h *Handle) Func(signature string) (*CallHandle, error) {
+func (h *Handle) Func(signature string) (fn *CallHandle, err error) {
+	if h.onThread(func() { fn, err = h.Func(signature) }) {
+		return fn, err
+	}
 	handle, err := h.Handle().Copy()
 	if err != nil {
 		return nil, err
 	}
-	cSignature := C.CString(signature)
-	defer C.free(unsafe.Pointer(cSignature))
 	vm := h.VM()
-	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature))}, nil
+	defer vm.arena.release(vm.arena.mark())
+	cSignature := vm.arena.cString(signature)
+	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature)), signature: signature}, nil
 }
 
-// NilHandleError is returned if there was an attempt to use a `Handle` that was freed already
+// NilHandleError is returned if there was an attempt to use a `Handle` that was freed already, or one from before its VM was reset or freed
 type NilHandleError struct {
 }
 
@@ -276,13 +797,17 @@ func (err *UnexpectedValue) Error() string {
 }
 
 // NewMap creates a new empty map object in wren and returns it's handle
-func (vm *VM) NewMap() (*MapHandle, error) {
+func (vm *VM) NewMap() (handle *MapHandle, err error) {
+	if vm.onThread(func() { handle, err = vm.NewMap() }) {
+		return handle, err
+	}
 	if vm.vm == nil {
 		return nil, &NilVMError{}
 	}
-	C.wrenEnsureSlots(vm.vm, 1)
-	C.wrenSetSlotNewMap(vm.vm, 0)
-	value := vm.getSlotValue(0)
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	C.wrenSetSlotNewMap(vm.vm, C.int(base))
+	value := vm.getSlotValue(base)
 	mapHandle, ok := value.(*MapHandle)
 	if !ok {
 		return nil, &UnexpectedValue{Value: value}
@@ -300,120 +825,300 @@ func (err *InvalidKey) Error() string {
 	return fmt.Sprintf("Type \"%v\" is not a valid type for map key", reflect.TypeOf(err.Key).String())
 }
 
-// Get tries to return the value in the Wren map with the key `key`
-func (h *MapHandle) Get(key interface{}) (interface{}, error) {
+// Get tries to return the value in the Wren map with the key `key`. If the map doesn't have the key, `KeyNotExist` is returned (see `GetOK` to check for missing keys without an error)
+func (h *MapHandle) Get(key interface{}) (value interface{}, err error) {
+	if h.handle.onThread(func() { value, err = h.Get(key) }) {
+		return value, err
+	}
+	value, ok, err := h.GetOK(key)
+	if err == nil && !ok {
+		return nil, &KeyNotExist{Map: h, Key: key}
+	}
+	return value, err
+}
+
+// GetOK returns the value in the Wren map with the key `key` and whether the map has the key, so a missing key can be told apart from a key set to null
+func (h *MapHandle) GetOK(key interface{}) (value interface{}, ok bool, err error) {
+	if h.handle.onThread(func() { value, ok, err = h.GetOK(key) }) {
+		return value, ok, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
-		return nil, &NilHandleError{}
+	if !handle.live() {
+		return nil, false, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 3)
-	vm.setSlotValue(handle, 0)
-	handle.vm.setSlotValue(key, 1)
-	switch C.wrenGetSlotType(vm.vm, 1) {
+	base := vm.reserveSlots(3)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	handle.vm.setSlotValue(key, base+1)
+	switch C.wrenGetSlotType(vm.vm, C.int(base+1)) {
 	case C.WREN_TYPE_NUM, C.WREN_TYPE_STRING, C.WREN_TYPE_BOOL, C.WREN_TYPE_NULL:
 	default:
-		return nil, &InvalidKey{Map: h, Key: key}
+		return nil, false, &InvalidKey{Map: h, Key: key}
 	}
-	if bool(C.wrenGetMapContainsKey(vm.vm, 0, 1)) {
-		C.wrenGetMapValue(vm.vm, 0, 1, 2)
-		v := vm.getSlotValue(2)
-		return v, nil
+	if !bool(C.wrenGetMapContainsKey(vm.vm, C.int(base), C.int(base+1))) {
+		return nil, false, nil
 	}
-	return nil, &KeyNotExist{Map: h, Key: key}
+	C.wrenGetMapValue(vm.vm, C.int(base), C.int(base+1), C.int(base+2))
+	return vm.getSlotValue(base + 2), true, nil
 }
 
 // Set tries to set the value in the Wren map with the key `key`
-func (h *MapHandle) Set(key, value interface{}) error {
+func (h *MapHandle) Set(key, value interface{}) (err error) {
+	if h.handle.onThread(func() { err = h.Set(key, value) }) {
+		return err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 3)
-	vm.setSlotValue(handle, 0)
-	vm.setSlotValue(key, 1)
-	switch C.wrenGetSlotType(vm.vm, 1) {
+	base := vm.reserveSlots(3)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	vm.setSlotValue(key, base+1)
+	switch C.wrenGetSlotType(vm.vm, C.int(base+1)) {
 	case C.WREN_TYPE_NUM, C.WREN_TYPE_STRING, C.WREN_TYPE_BOOL, C.WREN_TYPE_NULL:
 	default:
 		return &InvalidKey{Map: h, Key: key}
 	}
-	vm.setSlotValue(value, 2)
-	C.wrenSetMapValue(vm.vm, 0, 1, 2)
+	if err := vm.setSlotValue(value, base+2); err != nil {
+		return err
+	}
+	C.wrenSetMapValue(vm.vm, C.int(base), C.int(base+1), C.int(base+2))
 	return nil
 }
 
+// KeyErrors is returned from `MapHandle.SetAll` with the error for every key that couldn't be set. Every other key is still set
+type KeyErrors map[interface{}]error
+
+func (err KeyErrors) Error() string {
+	return fmt.Sprintf("%v keys could not be set in the map", len(err))
+}
+
+// SetAll sets every key in `values` to its value in the Wren map, all at once. Keys that aren't numbers, strings, booleans, or null (`InvalidKey`) and values that can't be passed to Wren are skipped and returned in `KeyErrors`
+func (h *MapHandle) SetAll(values map[interface{}]interface{}) (err error) {
+	if h.handle.onThread(func() { err = h.SetAll(values) }) {
+		return err
+	}
+	handle := h.Handle()
+	if !handle.live() {
+		return &NilHandleError{}
+	}
+	return h.VM().writeMap(h, values)
+}
+
+// MapResult is the result of looking up a key with `MapHandle.GetMany`
+type MapResult struct {
+	Value interface{}
+	// `KeyNotExist` if the map doesn't have the key or `InvalidKey` if it can't be a key
+	Err error
+}
+
+// GetMany looks up every key in `keys` in the Wren map at once. The results are in the same order as `keys`, each with its own error
+func (h *MapHandle) GetMany(keys []interface{}) (results []MapResult, err error) {
+	if h.handle.onThread(func() { results, err = h.GetMany(keys) }) {
+		return results, err
+	}
+	handle := h.Handle()
+	if !handle.live() {
+		return nil, &NilHandleError{}
+	}
+	return h.VM().readMap(h, keys)
+}
+
 // Delete removes a value from the Wren map with the key `key`
-func (h *MapHandle) Delete(key interface{}) (interface{}, error) {
+func (h *MapHandle) Delete(key interface{}) (value interface{}, err error) {
+	if h.handle.onThread(func() { value, err = h.Delete(key) }) {
+		return value, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 3)
-	vm.setSlotValue(handle, 0)
-	vm.setSlotValue(key, 1)
-	switch C.wrenGetSlotType(vm.vm, 1) {
+	base := vm.reserveSlots(3)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	vm.setSlotValue(key, base+1)
+	switch C.wrenGetSlotType(vm.vm, C.int(base+1)) {
 	case C.WREN_TYPE_NUM, C.WREN_TYPE_STRING, C.WREN_TYPE_BOOL, C.WREN_TYPE_NULL:
 	default:
 		return nil, &InvalidKey{Map: h, Key: key}
 	}
-	C.wrenRemoveMapValue(vm.vm, 0, 1, 2)
-	return vm.getSlotValue(2), nil
+	C.wrenRemoveMapValue(vm.vm, C.int(base), C.int(base+1), C.int(base+2))
+	return vm.getSlotValue(base + 2), nil
 }
 
 // Has check if a wren map has a value with the key `key`
-func (h *MapHandle) Has(key interface{}) (bool, error) {
+func (h *MapHandle) Has(key interface{}) (ok bool, err error) {
+	if h.handle.onThread(func() { ok, err = h.Has(key) }) {
+		return ok, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return false, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 2)
-	vm.setSlotValue(handle, 0)
-	vm.setSlotValue(key, 1)
-	switch C.wrenGetSlotType(vm.vm, 1) {
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	vm.setSlotValue(key, base+1)
+	switch C.wrenGetSlotType(vm.vm, C.int(base+1)) {
 	case C.WREN_TYPE_NUM, C.WREN_TYPE_STRING, C.WREN_TYPE_BOOL, C.WREN_TYPE_NULL:
 	default:
 		return false, &InvalidKey{Map: h, Key: key}
 	}
-	return bool(C.wrenGetMapContainsKey(vm.vm, 0, 1)), nil
+	return bool(C.wrenGetMapContainsKey(vm.vm, C.int(base), C.int(base+1))), nil
 }
 
 // Count counts how many elements are in the Wren map
-func (h *MapHandle) Count() (int, error) {
+func (h *MapHandle) Count() (count int, err error) {
+	if h.handle.onThread(func() { count, err = h.Count() }) {
+		return count, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return 0, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 1)
-	vm.setSlotValue(handle, 0)
-	return int(C.wrenGetMapCount(vm.vm, 0)), nil
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	return int(C.wrenGetMapCount(vm.vm, C.int(base))), nil
+}
+
+// Keys returns the keys of the Wren map. Keys that aren't numbers, strings, booleans, or null (such as classes and ranges) are returned as handles that should be freed. Wren maps can only be enumerated by calling into Wren so this returns `RunningVMError` if the VM is already running (such as from inside of a foreign method)
+func (h *MapHandle) Keys() (values []interface{}, err error) {
+	if h.handle.onThread(func() { values, err = h.Keys() }) {
+		return values, err
+	}
+	handle := h.Handle()
+	if !handle.live() {
+		return nil, &NilHandleError{}
+	}
+	vm := h.VM()
+	keys, err := h.Func("keys")
+	if err != nil {
+		return nil, err
+	}
+	defer keys.Free()
+	value, err := keys.Call()
+	if err != nil {
+		return nil, err
+	}
+	defer vm.FreeAll(value)
+	sequence, ok := value.(*Handle)
+	if !ok {
+		return nil, &UnexpectedValue{Value: value}
+	}
+	toList, err := sequence.Func("toList")
+	if err != nil {
+		return nil, err
+	}
+	defer toList.Free()
+	if value, err = toList.Call(); err != nil {
+		return nil, err
+	}
+	list, ok := value.(*ListHandle)
+	if !ok {
+		vm.FreeAll(value)
+		return nil, &UnexpectedValue{Value: value}
+	}
+	defer list.Free()
+	return list.ToSlice(false)
+}
+
+// ForEach calls `fn` with every key and value in the Wren map, stopping at the first error `fn` returns. Handles passed to `fn` are freed after it returns so they should be copied if they are still needed. Like `Keys`, this cannot be used while the VM is running
+func (h *MapHandle) ForEach(fn func(key, value interface{}) error) (err error) {
+	if h.handle.onThread(func() { err = h.ForEach(fn) }) {
+		return err
+	}
+	keys, err := h.Keys()
+	if err != nil {
+		return err
+	}
+	vm := h.VM()
+	defer vm.FreeAll(keys...)
+	for _, key := range keys {
+		value, err := h.Get(key)
+		if err != nil {
+			return err
+		}
+		err = fn(key, value)
+		vm.FreeAll(value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToMap copies the contents of the Wren map into a Go map. If `recursive` is true, nested lists and maps are converted into slices and maps as well, otherwise they are returned as handles that should be freed. Like `Keys`, this cannot be used while the VM is running
+func (h *MapHandle) ToMap(recursive bool) (values map[interface{}]interface{}, err error) {
+	if h.handle.onThread(func() { values, err = h.ToMap(recursive) }) {
+		return values, err
+	}
+	keys, err := h.Keys()
+	if err != nil {
+		return nil, err
+	}
+	vm := h.VM()
+	m := make(map[interface{}]interface{}, len(keys))
+	for _, key := range keys {
+		value, err := h.Get(key)
+		if err == nil && recursive {
+			value, err = toGo(value)
+		}
+		if err != nil {
+			vm.FreeAll(keys...)
+			for _, value := range m {
+				vm.FreeAll(value)
+			}
+			return nil, err
+		}
+		m[key] = value
+	}
+	return m, nil
+}
+
+// String returns what calling `toString` on the map gives in Wren, or "<map>" if the VM is running
+func (h *MapHandle) String() (s string) {
+	if h.handle.onThread(func() { s = h.String() }) {
+		return s
+	}
+	return h.VM().toString(h.handle, "<map>")
 }
 
 // Func creates a callable handle from the Wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
-func (h *MapHandle) Func(signature string) (*CallHandle, error) {
+func (h *MapHandle) Func(signature string) (fn *CallHandle, err error) {
+	if h.handle.onThread(func() { fn, err = h.Func(signature) }) {
+		return fn, err
+	}
 	handle, err := h.Handle().Copy()
 	if err != nil {
 		return nil, err
 	}
-	cSignature := C.CString(signature)
-	defer C.free(unsafe.Pointer(cSignature))
 	vm := h.VM()
-	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature))}, nil
+	defer vm.arena.release(vm.arena.mark())
+	cSignature := vm.arena.cString(signature)
+	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature)), signature: signature}, nil
 }
 
 // Copy creates a new `MapHandle` tied to this Wren map, if the previous one is freed the new one should still persist
-func (h *MapHandle) Copy() (*MapHandle, error) {
+func (h *MapHandle) Copy() (copied *MapHandle, err error) {
+	if h.handle.onThread(func() { copied, err = h.Copy() }) {
+		return copied, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 0)
-	vm.setSlotValue(handle, 0)
-	return &MapHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, 0))}, nil
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	return &MapHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, C.int(base)))}, nil
 }
 
 // ListHandle is a handle to a list object in Wren
@@ -437,13 +1142,17 @@ func (h *ListHandle) Handle() *Handle {
 }
 
 // NewList creates a new empty list object in wren and returns it's handle
-func (vm *VM) NewList() (*ListHandle, error) {
+func (vm *VM) NewList() (handle *ListHandle, err error) {
+	if vm.onThread(func() { handle, err = vm.NewList() }) {
+		return handle, err
+	}
 	if vm.vm == nil {
 		return nil, &NilVMError{}
 	}
-	C.wrenEnsureSlots(vm.vm, 1)
-	C.wrenSetSlotNewList(vm.vm, 0)
-	value := vm.getSlotValue(0)
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	C.wrenSetSlotNewList(vm.vm, C.int(base))
+	value := vm.getSlotValue(base)
 	listHandle, ok := value.(*ListHandle)
 	if !ok {
 		return nil, &UnexpectedValue{Value: value}
@@ -461,99 +1170,231 @@ func (err *OutOfBounds) Error() string {
 	return fmt.Sprintf("Index %v is out of bounds", err.Index)
 }
 
-// Get tries to return the value in the Wren list at the index `index`
-func (h *ListHandle) Get(index int) (interface{}, error) {
+// listIndex converts `index` into an index from the start of a list with `count` elements. Like in Wren, negative indices count back from the end of the list. If `inserting` is true, the index may also be one past the last element (so -1 is the end of the list)
+func listIndex(index, count int, inserting bool) (int, bool) {
+	if inserting {
+		count++
+	}
+	if index < 0 {
+		index += count
+	}
+	return index, index >= 0 && index < count
+}
+
+// Get tries to return the value in the Wren list at the index `index`. Negative indices count back from the end of the list
+func (h *ListHandle) Get(index int) (value interface{}, err error) {
+	if h.handle.onThread(func() { value, err = h.Get(index) }) {
+		return value, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 2)
-	vm.setSlotValue(handle, 0)
-	if index < 0 || index >= int(C.wrenGetListCount(vm.vm, C.int(index))) {
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	i, ok := listIndex(index, int(C.wrenGetListCount(vm.vm, C.int(base))), false)
+	if !ok {
 		return nil, &OutOfBounds{List: h, Index: index}
 	}
-	C.wrenGetListElement(vm.vm, 0, C.int(index), 1)
-	return vm.getSlotValue(1), nil
+	C.wrenGetListElement(vm.vm, C.int(base), C.int(i), C.int(base+1))
+	return vm.getSlotValue(base + 1), nil
 }
 
 // Insert tries to insert an element into the wren list at the end
-func (h *ListHandle) Insert(value interface{}) error {
+func (h *ListHandle) Insert(value interface{}) (err error) {
+	if h.handle.onThread(func() { err = h.Insert(value) }) {
+		return err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 2)
-	vm.setSlotValue(handle, 0)
-	vm.setSlotValue(value, 1)
-	C.wrenInsertInList(vm.vm, 0, -1, 1)
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	if err := vm.setSlotValue(value, base+1); err != nil {
+		return err
+	}
+	C.wrenInsertInList(vm.vm, C.int(base), -1, C.int(base+1))
 	return nil
 }
 
-// InsertAt tries to insert an element into the wren list at index `index`
-func (h *ListHandle) InsertAt(index int, value interface{}) error {
+// Append inserts `values` at the end of the Wren list. All of the values are passed to Wren at once, which is faster than inserting them one at a time
+func (h *ListHandle) Append(values ...interface{}) (err error) {
+	if h.handle.onThread(func() { err = h.Append(values...) }) {
+		return err
+	}
+	handle := h.Handle()
+	if !handle.live() {
+		return &NilHandleError{}
+	}
+	return h.VM().writeList(handle, -1, values)
+}
+
+// SetAll sets the elements of the Wren list starting at `start` to `values`, all at once. Values past the end of the list are appended, so `start` may also be the length of the list. Negative indices count back from the end of the list
+func (h *ListHandle) SetAll(start int, values []interface{}) (err error) {
+	if h.handle.onThread(func() { err = h.SetAll(start, values) }) {
+		return err
+	}
+	count, err := h.Count()
+	if err != nil {
+		return err
+	}
+	index, ok := start, start <= count
+	if start < 0 {
+		index, ok = listIndex(start, count, false)
+	}
+	if !ok {
+		return &OutOfBounds{List: h, Index: start}
+	}
+	return h.VM().writeList(h.handle, index, values)
+}
+
+// InsertAt tries to insert an element into the wren list at index `index`. Like `List.insert` in Wren, negative indices count back from one past the end of the list, so -1 inserts at the end
+func (h *ListHandle) InsertAt(index int, value interface{}) (err error) {
+	if h.handle.onThread(func() { err = h.InsertAt(index, value) }) {
+		return err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 2)
-	vm.setSlotValue(handle, 0)
-	vm.setSlotValue(value, 1)
-	C.wrenInsertInList(vm.vm, 0, C.int(index), 1)
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	i, ok := listIndex(index, int(C.wrenGetListCount(vm.vm, C.int(base))), true)
+	if !ok {
+		return &OutOfBounds{List: h, Index: index}
+	}
+	if err := vm.setSlotValue(value, base+1); err != nil {
+		return err
+	}
+	C.wrenInsertInList(vm.vm, C.int(base), C.int(i), C.int(base+1))
 	return nil
 }
 
 // Count counts how many elements are in the Wren list
-func (h *ListHandle) Count() (int, error) {
+func (h *ListHandle) Count() (count int, err error) {
+	if h.handle.onThread(func() { count, err = h.Count() }) {
+		return count, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return 0, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 1)
-	vm.setSlotValue(handle, 0)
-	return int(C.wrenGetListCount(vm.vm, 0)), nil
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	return int(C.wrenGetListCount(vm.vm, C.int(base))), nil
 }
 
-// Set tries to set the value in the Wren list at the index `index`
-func (h *ListHandle) Set(index int, value interface{}) error {
+// ToSlice copies every element of the Wren list into a slice with a single call into C. If `recursive` is true, nested lists and maps are converted into slices and maps as well (see `MapHandle.ToMap`), otherwise they are returned as handles that should be freed. Lists that contain themselves should not be converted recursively
+func (h *ListHandle) ToSlice(recursive bool) (values []interface{}, err error) {
+	if h.handle.onThread(func() { values, err = h.ToSlice(recursive) }) {
+		return values, err
+	}
+	handle := h.Handle()
+	if !handle.live() {
+		return nil, &NilHandleError{}
+	}
+	vm := h.VM()
+	base := vm.reserveSlots(2)
+	vm.setSlotValue(handle, base)
+	values = vm.getListElements(base, base+1)
+	vm.releaseSlots(base)
+	if recursive {
+		for i, value := range values {
+			converted, err := toGo(value)
+			if err != nil {
+				vm.FreeAll(values[i+1:]...)
+				return nil, err
+			}
+			values[i] = converted
+		}
+	}
+	return values, nil
+}
+
+// toGo converts list and map handles into Go slices and maps, freeing the handle. Other values are returned as they are
+func toGo(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case *ListHandle:
+		defer value.Free()
+		return value.ToSlice(true)
+	case *MapHandle:
+		defer value.Free()
+		return value.ToMap(true)
+	}
+	return value, nil
+}
+
+// Set tries to set the value in the Wren list at the index `index`. Negative indices count back from the end of the list
+func (h *ListHandle) Set(index int, value interface{}) (err error) {
+	if h.handle.onThread(func() { err = h.Set(index, value) }) {
+		return err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 2)
-	vm.setSlotValue(handle, 0)
-	vm.setSlotValue(value, 1)
-	C.wrenSetListElement(vm.vm, 0, C.int(index), 1)
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	i, ok := listIndex(index, int(C.wrenGetListCount(vm.vm, C.int(base))), false)
+	if !ok {
+		return &OutOfBounds{List: h, Index: index}
+	}
+	if err := vm.setSlotValue(value, base+1); err != nil {
+		return err
+	}
+	C.wrenSetListElement(vm.vm, C.int(base), C.int(i), C.int(base+1))
 	return nil
 
 }
 
+// String returns what calling `toString` on the list gives in Wren, or "<list>" if the VM is running
+func (h *ListHandle) String() (s string) {
+	if h.handle.onThread(func() { s = h.String() }) {
+		return s
+	}
+	return h.VM().toString(h.handle, "<list>")
+}
+
 // Func creates a callable handle from the Wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
-func (h *ListHandle) Func(signature string) (*CallHandle, error) {
+func (h *ListHandle) Func(signature string) (fn *CallHandle, err error) {
+	if h.handle.onThread(func() { fn, err = h.Func(signature) }) {
+		return fn, err
+	}
 	handle, err := h.Handle().Copy()
 	if err != nil {
 		return nil, err
 	}
-	cSignature := C.CString(signature)
-	defer C.free(unsafe.Pointer(cSignature))
 	vm := h.VM()
-	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature))}, nil
+	defer vm.arena.release(vm.arena.mark())
+	cSignature := vm.arena.cString(signature)
+	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature)), signature: signature}, nil
 }
 
 // Copy creates a new `ListHandle` tied to this Wren list, if the previous one is freed the new one should still persist
-func (h *ListHandle) Copy() (*ListHandle, error) {
+func (h *ListHandle) Copy() (copied *ListHandle, err error) {
+	if h.handle.onThread(func() { copied, err = h.Copy() }) {
+		return copied, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 0)
-	vm.setSlotValue(handle, 0)
-	return &ListHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, 0))}, nil
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	return &ListHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, C.int(base)))}, nil
 }
 
 // ForeignHandle is a handle to a foreign object in Wren
@@ -576,26 +1417,41 @@ func (h *ForeignHandle) Handle() *Handle {
 	return h.handle
 }
 
+// String returns what calling `toString` on the foreign object gives in Wren, or "<foreign>" if the VM is running
+func (h *ForeignHandle) String() (s string) {
+	if h.handle.onThread(func() { s = h.String() }) {
+		return s
+	}
+	return h.VM().toString(h.handle, "<foreign>")
+}
+
 // Func creates a callable handle from the Wren object tied to the current handle. There isn't currently a way to check if the function referenced from `signature` exists before calling it
-func (h *ForeignHandle) Func(signature string) (*CallHandle, error) {
+func (h *ForeignHandle) Func(signature string) (fn *CallHandle, err error) {
+	if h.handle.onThread(func() { fn, err = h.Func(signature) }) {
+		return fn, err
+	}
 	handle, err := h.Handle().Copy()
 	if err != nil {
 		return nil, err
 	}
-	cSignature := C.CString(signature)
-	defer C.free(unsafe.Pointer(cSignature))
 	vm := h.VM()
-	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature))}, nil
+	defer vm.arena.release(vm.arena.mark())
+	cSignature := vm.arena.cString(signature)
+	return &CallHandle{receiver: handle, handle: vm.createHandle(C.wrenMakeCallHandle(vm.vm, cSignature)), signature: signature}, nil
 }
 
-func (h *Handle) Copy() (*Handle, error) {
-	if h.handle == nil {
+func (h *Handle) Copy() (copied *Handle, err error) {
+	if h.onThread(func() { copied, err = h.Copy() }) {
+		return copied, err
+	}
+	if !h.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 0)
-	vm.setSlotValue(h, 0)
-	return vm.createHandle(C.wrenGetSlotHandle(vm.vm, 0)), nil
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(h, base)
+	return vm.createHandle(C.wrenGetSlotHandle(vm.vm, C.int(base))), nil
 }
 
 // UnknownForeign is returned if a foreign value was not set by WrenGo
@@ -608,72 +1464,200 @@ func (err *UnknownForeign) Error() string {
 }
 
 // Get tries to get the original value that this `ForeignHandle` set to
-func (h *ForeignHandle) Get() (interface{}, error) {
+func (h *ForeignHandle) Get() (value interface{}, err error) {
+	if h.handle.onThread(func() { value, err = h.Get() }) {
+		return value, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.handle.vm
-	C.wrenEnsureSlots(vm.vm, 1)
-	vm.setSlotValue(h.handle, 0)
-	ptr := C.wrenGetSlotForeign(vm.vm, 0)
-	foreignMapMux.RLock()
-	defer foreignMapMux.RUnlock()
-	if foreign, ok := foreignMap[ptr]; ok {
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(h.handle, base)
+	if foreign, ok := vm.foreignValue(C.wrenGetSlotForeign(vm.vm, C.int(base))); ok {
 		return foreign.value, nil
 	}
 	return nil, &UnknownForeign{Handle: h}
 }
 
 // Copy creates a new `ForeignHandle` tied to this foreign object, if the previous one is freed the new one should still persist
-func (h *ForeignHandle) Copy() (*ForeignHandle, error) {
+func (h *ForeignHandle) Copy() (copied *ForeignHandle, err error) {
+	if h.handle.onThread(func() { copied, err = h.Copy() }) {
+		return copied, err
+	}
 	handle := h.Handle()
-	if handle.handle == nil {
+	if !handle.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.VM()
-	C.wrenEnsureSlots(vm.vm, 0)
-	vm.setSlotValue(handle, 0)
-	return &ForeignHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, 0))}, nil
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	vm.setSlotValue(handle, base)
+	return &ForeignHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, C.int(base)))}, nil
+}
+
+// NotForeignClass is returned by `NewForeign` if a variable isn't a foreign class that was set with `SetModule`
+type NotForeignClass struct {
+	Module, Class string
+}
+
+func (err *NotForeignClass) Error() string {
+	return fmt.Sprintf("\"%v\" in module \"%v\" is not a foreign class set from Go", err.Class, err.Module)
+}
+
+// NewForeign creates an instance of the foreign class `class` from the module `module` that holds `value`, without calling the class's initializer. The class's finalizer is still called when the instance is garbage collected. The class must be declared by a script and set in `SetModule`. Like `NewFn`, this can be used while the VM is running
+func (vm *VM) NewForeign(module, class string, value interface{}) (handle *ForeignHandle, err error) {
+	if vm.onThread(func() { handle, err = vm.NewForeign(module, class, value) }) {
+		return handle, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
+	}
+	definition, ok := vm.moduleMap[module]
+	if !ok {
+		return nil, &NotForeignClass{Module: module, Class: class}
+	}
+	foreignClass, ok := definition.ClassMap[class]
+	if !ok {
+		return nil, &NotForeignClass{Module: module, Class: class}
+	}
+	defer vm.arena.release(vm.arena.mark())
+	cModule := vm.arena.cString(module)
+	cClass := vm.arena.cString(class)
+	if !C.wrenHasModule(vm.vm, cModule) {
+		return nil, &NoSuchModule{Module: module}
+	}
+	if !C.wrenHasVariable(vm.vm, cModule, cClass) {
+		return nil, &NoSuchVariable{Module: module, Name: class}
+	}
+	base := vm.reserveSlots(2)
+	defer vm.releaseSlots(base)
+	C.wrenGetVariable(vm.vm, cModule, cClass, C.int(base))
+	if !vm.isForeignClass(base) {
+		return nil, &NotForeignClass{Module: module, Class: class}
+	}
+	vm.newForeign(base+1, base, foreignInstance{finalizer: foreignClass.Finalizer, value: value})
+	return &ForeignHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, C.int(base+1)))}, nil
 }
 
 // CallHandle is a handle to a wren function
 type CallHandle struct {
-	receiver *Handle
-	handle   *Handle
+	receiver  *Handle
+	handle    *Handle
+	signature string
+	// where `CallStatic` cached it, if it did
+	key *staticCall
 }
 
-// Free releases the handle tied to it. The handle should be freed when no longer in use. The handle should not be used after it has been freed
+// Free releases the handle tied to it, along with the copy of the receiver made by `Func`. The handle should be freed when no longer in use. The handle should not be used after it has been freed. If `CallStatic` cached it, it is made again the next time it is needed
 func (h *CallHandle) Free() {
+	if h.handle.onThread(h.Free) {
+		return
+	}
+	if vm := h.handle.vm; vm != nil && h.key != nil {
+		delete(vm.calls, *h.key)
+		h.key = nil
+	}
+	h.receiver.Free()
 	h.handle.Free()
 }
 
 // Call tries to call the function on the handles that created the `CallHandle`. The amount of parameters should coorespond to the signature used to create this function. This function should not be called if the VM is already running.
-func (h *CallHandle) Call(parameters ...interface{}) (interface{}, error) {
+func (h *CallHandle) Call(parameters ...interface{}) (value interface{}, err error) {
+	if h.handle.vm != nil && h.handle.vm.onThread(func() { value, err = h.Call(parameters...) }) {
+		return value, err
+	}
 	handle := h.handle
-	if handle.handle == nil {
+	if !handle.live() {
 		return nil, &NilHandleError{}
 	}
 	vm := h.handle.vm
 	if vm.running {
-		return nil, &RunningVMError{}
+		return nil, vm.reenter(func() error {
+			_, err := h.Call(parameters...)
+			return err
+		})
+	}
+	defer vm.runQueued()
+	if err := vm.setSlots(0, append([]interface{}{h.receiver}, parameters...)...); err != nil {
+		return nil, err
+	}
+	count(&vm.stats.Calls)
+	if hook := vm.Config.BeforeCall; hook != nil {
+		hook(vm, h.signature)
+	}
+	vm.startRun()
+	err = vm.finishRun(resultsToError(C.wrenCall(vm.vm, handle.handle)))
+	if hook := vm.Config.AfterCall; hook != nil {
+		hook(vm, h.signature, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if vm.suspended() {
+		// the call suspended the VM with `Fiber.suspend()` so there is no return value
+		return nil, nil
 	}
-	slots := C.int(len(parameters) + 1)
-	C.wrenEnsureSlots(vm.vm, slots)
-	vm.setSlotValue(h.receiver, 0)
-	for i, param := range parameters {
-		err := vm.setSlotValue(param, i+1)
+	return vm.getSlotValue(0), nil
+}
+
+// staticCall identifies a call handle cached by `CallStatic`
+type staticCall struct {
+	module, class, signature string
+}
+
+// CallStatic calls the static method `signature` (such as "add(_,_)") on the class `class` from `module`. The call handle is created the first time and reused by later calls with the same module, class, and signature
+func (vm *VM) CallStatic(module, class, signature string, args ...interface{}) (value interface{}, err error) {
+	if vm.onThread(func() { value, err = vm.CallStatic(module, class, signature, args...) }) {
+		return value, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
+	}
+	key := staticCall{module: module, class: class, signature: strings.TrimPrefix(signature, "static ")}
+	fn, ok := vm.calls[key]
+	if !ok {
+		receiver, err := vm.GetClass(module, class)
+		if err != nil {
+			return nil, err
+		}
+		defer receiver.Free()
+		fn, err = receiver.Func(key.signature)
 		if err != nil {
 			return nil, err
 		}
+		if vm.calls == nil {
+			vm.calls = make(map[staticCall]*CallHandle)
+		}
+		fn.key = &key
+		vm.calls[key] = fn
+	}
+	return fn.Call(args...)
+}
+
+// Call calls the method `signature` (such as "update(_)") on the module variable `variable` from `module` once. The variable can be a class or any other object, and the handles made to look it up and call it are freed before returning. To call the same static method many times, `CallStatic` reuses its call handle instead. Its result is needed right away, so while the VM is running it returns `ReentrantCallError` even if `Config.QueueReentrantCalls` is set
+func (vm *VM) Call(module, variable, signature string, args ...interface{}) (value interface{}, err error) {
+	if vm.onThread(func() { value, err = vm.Call(module, variable, signature, args...) }) {
+		return value, err
+	}
+	if vm.vm == nil {
+		return nil, &NilVMError{}
 	}
-	vm.running = true
-	err := resultsToError(C.wrenCall(vm.vm, handle.handle))
-	vm.running = false
+	if vm.running {
+		return nil, &ReentrantCallError{}
+	}
+	object, err := vm.GetVariable(module, variable)
 	if err != nil {
 		return nil, err
 	}
-	return vm.getSlotValue(0), nil
+	defer vm.FreeAll(object)
+	receiver, ok := object.(freeableHandle)
+	if !ok {
+		return nil, &UnexpectedValue{Value: object}
+	}
+	return vm.callMethod(receiver.Handle(), strings.TrimPrefix(signature, "static "), args...)
 }
 
 type freeable interface {
@@ -684,7 +1668,7 @@ type freeable interface {
 func (vm *VM) FreeAll(items ...interface{}) {
 	for _, item := range items {
 		switch item.(type) {
-		case *Handle, *CallHandle, *ForeignHandle, *ListHandle, *MapHandle:
+		case *Handle, *CallHandle, *ForeignHandle, *ListHandle, *MapHandle, *FiberHandle, *ClassHandle, *FnHandle, *RangeHandle:
 			item.(freeable).Free()
 		}
 	}
@@ -692,122 +1676,11 @@ func (vm *VM) FreeAll(items ...interface{}) {
 
 // GC runs the garbage collector on the `VM`
 func (vm *VM) GC() {
-	C.wrenCollectGarbage(vm.vm)
-}
-
-func (vm *VM) getAllSlots() []interface{} {
-	slotCount := int(C.wrenGetSlotCount(vm.vm))
-	params := make([]interface{}, slotCount)
-	for i := 0; i < slotCount; i++ {
-		params[i] = vm.getSlotValue(i)
-	}
-	return params
-}
-
-func (vm *VM) getSlotValue(slot int) (value interface{}) {
-	cSlot := C.int(slot)
-	switch C.wrenGetSlotType(vm.vm, C.int(cSlot)) {
-	case C.WREN_TYPE_BOOL:
-		return bool(C.wrenGetSlotBool(vm.vm, cSlot))
-	case C.WREN_TYPE_NUM:
-		return float64(C.wrenGetSlotDouble(vm.vm, cSlot))
-	case C.WREN_TYPE_FOREIGN:
-		return &ForeignHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, cSlot))}
-	case C.WREN_TYPE_LIST:
-		return &ListHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, cSlot))}
-	case C.WREN_TYPE_MAP:
-		return &MapHandle{handle: vm.createHandle(C.wrenGetSlotHandle(vm.vm, cSlot))}
-	case C.WREN_TYPE_NULL:
-		return nil
-	case C.WREN_TYPE_STRING:
-		var length C.int
-		str := C.wrenGetSlotBytes(vm.vm, cSlot, &length)
-		return string(C.GoBytes(unsafe.Pointer(str), length))
-	case C.WREN_TYPE_UNKNOWN:
-		return vm.createHandle(C.wrenGetSlotHandle(vm.vm, cSlot))
-	default:
-		panic("Unreachable")
-	}
-}
-
-// InvalidValue is returned if there was an attempt to pass a value to Wren that WrenGo cannot process. Note that Go maps, lists, and slices (other than byte slices), may also send this error. `ListHandle`s and `MapHandle`s should be used instead of list and maps.
-type InvalidValue struct {
-	Value interface{}
-}
-
-func (err InvalidValue) Error() string {
-	return fmt.Sprintf("WrenGo does not know how to handle the value type \"%v\"", reflect.TypeOf(err.Value).String())
-}
-
-// NonMatchingVM is returned if there was an attempt to use a handle in a VM that it did not originate from
-type NonMatchingVM struct{}
-
-func (err *NonMatchingVM) Error() string {
-	return "Cannot set value to VM because it didn't originate from this VM"
-}
-
-func (vm *VM) setSlotValue(value interface{}, slot int) error {
-	cSlot := C.int(slot)
-	switch value.(type) {
-	case *Handle:
-		handle := value.(*Handle)
-		if handle.VM() != vm {
-			return &NonMatchingVM{}
-		}
-		cValue := handle.handle
-		C.wrenSetSlotHandle(vm.vm, cSlot, cValue)
-	case *ListHandle:
-		handle := value.(*ListHandle)
-		if handle.VM() != vm {
-			return &NonMatchingVM{}
-		}
-		cValue := handle.handle.handle
-		C.wrenSetSlotHandle(vm.vm, cSlot, cValue)
-	case *MapHandle:
-		handle := value.(*MapHandle)
-		if handle.VM() != vm {
-			return &NonMatchingVM{}
-		}
-		cValue := handle.handle.handle
-		C.wrenSetSlotHandle(vm.vm, cSlot, cValue)
-	case *ForeignHandle:
-		handle := value.(*ForeignHandle)
-		if handle.VM() != vm {
-			return &NonMatchingVM{}
-		}
-		cValue := handle.handle.handle
-		C.wrenSetSlotHandle(vm.vm, cSlot, cValue)
-	case []byte:
-		data := value.([]byte)
-		cValue := C.CBytes(data)
-		C.wrenSetSlotBytes(vm.vm, cSlot, (*C.char)(cValue), C.size_t(len(data)))
-	case bool:
-		cValue := C.bool(value.(bool))
-		C.wrenSetSlotBool(vm.vm, cSlot, cValue)
-	case string:
-		data := []byte(value.(string))
-		cValue := C.CBytes(data)
-		defer C.free(unsafe.Pointer(cValue))
-		C.wrenSetSlotBytes(vm.vm, cSlot, (*C.char)(cValue), C.size_t(len(data)))
-	default:
-		switch v := reflect.ValueOf(value); v.Kind() {
-		case reflect.Float32, reflect.Float64:
-			cValue := C.double(v.Float())
-			C.wrenSetSlotDouble(vm.vm, cSlot, cValue)
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			cValue := C.double(v.Int())
-			C.wrenSetSlotDouble(vm.vm, cSlot, cValue)
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			cValue := C.double(v.Uint())
-			C.wrenSetSlotDouble(vm.vm, cSlot, cValue)
-		case reflect.Invalid:
-			C.wrenSetSlotNull(vm.vm, cSlot)
-		default:
-			C.wrenSetSlotNull(vm.vm, cSlot)
-			return &InvalidValue{Value: value}
-		}
+	if vm.onThread(vm.GC) {
+		return
 	}
-	return nil
+	count(&vm.stats.GCs)
+	C.wrenCollectGarbage(vm.vm)
 }
 
 // NoSuchVariable is returned when `GetVariable` cannot get a variable from a module
@@ -827,92 +1700,161 @@ func (err *NoSuchModule) Error() string {
 	return fmt.Sprintf("Module \"%s\" has not been resolved by this VM yet", err.Module)
 }
 
+// ModuleNotImported is returned if a value from one of WrenGo's own modules (such as a function from `NewFn`, a channel from `NewChannel` or a `DateTime` from a `time.Time`) is created while the VM is running and the module wasn't defined yet. These modules are only defined once they are used, which Wren can't do while it is running, so a script that is given such values by foreign methods should import the module first (such as `import "wrengo/fn"`)
+type ModuleNotImported struct {
+	Module string
+}
+
+func (err *ModuleNotImported) Error() string {
+	return fmt.Sprintf("Module \"%s\" has to be imported before its values can be created while the VM is running", err.Module)
+}
+
+// goModules are WrenGo's own modules that hold the classes of values it passes to Wren. They are only defined once a script imports them or Go needs them (see `requireModule`)
+var goModules = map[string]func() *Module{
+	fnModule:       fnModuleDefinition,
+	DateTimeModule: dateTimeModuleDefinition,
+	channelModule:  channelModuleDefinition,
+}
+
+// requireModule defines the module `name` from `definition` if it isn't defined yet, so Go can create instances of its classes
+func (vm *VM) requireModule(name string, definition func() *Module) error {
+	if vm.HasModule(name) {
+		return nil
+	}
+	// interpreting would also discard slots that are being written, such as when a `time.Time` is passed to a call
+	if vm.running || vm.slotTop > 0 {
+		return &ModuleNotImported{Module: name}
+	}
+	if _, ok := vm.moduleMap[name]; !ok {
+		vm.setModule(name, definition())
+	}
+	return vm.InterpretString(name, vm.moduleMap[name].source())
+}
+
 // GetVariable tries to get a variable from the Wren vm with the given module name and variable name. This function checks that `HasVariable` is true to prevent segfaults
-func (vm *VM) GetVariable(module, name string) (interface{}, error) {
+func (vm *VM) GetVariable(module, name string) (value interface{}, err error) {
+	if vm.onThread(func() { value, err = vm.GetVariable(module, name) }) {
+		return value, err
+	}
 	if vm.vm == nil {
 		return nil, &NilVMError{}
 	}
-	cModule := C.CString(module)
-	cName := C.CString(name)
-	defer func() {
-		C.free(unsafe.Pointer(cModule))
-		C.free(unsafe.Pointer(cName))
-	}()
+	defer vm.arena.release(vm.arena.mark())
+	cModule := vm.arena.cString(module)
+	cName := vm.arena.cString(name)
 	if !C.wrenHasModule(vm.vm, cModule) {
 		return nil, &NoSuchModule{Module: module}
 	}
 	if !C.wrenHasVariable(vm.vm, cModule, cName) {
 		return nil, &NoSuchVariable{Module: module, Name: name}
 	}
-	C.wrenEnsureSlots(vm.vm, 1)
-	C.wrenGetVariable(vm.vm, cModule, cName, 0)
-	return vm.getSlotValue(0), nil
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	C.wrenGetVariable(vm.vm, cModule, cName, C.int(base))
+	return vm.getSlotValue(base), nil
 }
 
 // GetVariableUnsafe is like `GetVariable` but does not perform any checks to ensure that things aren't null (This function will segfault if things don't exist)
-func (vm *VM) GetVariableUnsafe(module, name string) interface{} {
+func (vm *VM) GetVariableUnsafe(module, name string) (value interface{}) {
+	if vm.onThread(func() { value = vm.GetVariableUnsafe(module, name) }) {
+		return value
+	}
 	// TODO: May add more of these "Unsafe" functions for simplicity and performance?
-	cModule := C.CString(module)
-	cName := C.CString(name)
-	defer func() {
-		C.free(unsafe.Pointer(cModule))
-		C.free(unsafe.Pointer(cName))
-	}()
-	C.wrenEnsureSlots(vm.vm, 1)
-	C.wrenGetVariable(vm.vm, cModule, cName, 0)
-	return vm.getSlotValue(0)
+	defer vm.arena.release(vm.arena.mark())
+	cModule := vm.arena.cString(module)
+	cName := vm.arena.cString(name)
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	C.wrenGetVariable(vm.vm, cModule, cName, C.int(base))
+	return vm.getSlotValue(base)
 
 }
 
 // HasVariable tries to check that a variable from the Wren vm with the given module name and variable name exists. This function checks that `HasModule` is true to prevent segfaults
-func (vm *VM) HasVariable(module, name string) bool {
-	cModule := C.CString(module)
-	cName := C.CString(name)
-	if vm.vm == nil || !C.wrenHasModule(vm.vm, cModule) {
+func (vm *VM) HasVariable(module, name string) (ok bool) {
+	if vm.onThread(func() { ok = vm.HasVariable(module, name) }) {
+		return ok
+	}
+	if vm.vm == nil {
 		return false
 	}
-	defer func() {
-		C.free(unsafe.Pointer(cModule))
-		C.free(unsafe.Pointer(cName))
-	}()
+	defer vm.arena.release(vm.arena.mark())
+	cModule := vm.arena.cString(module)
+	cName := vm.arena.cString(name)
 	return bool(vm.vm != nil && C.wrenHasModule(vm.vm, cModule) && C.wrenHasVariable(vm.vm, cModule, cName))
 }
 
 // HasModule tries to check that a module has been imported or resolved before
-func (vm *VM) HasModule(module string) bool {
+func (vm *VM) HasModule(module string) (ok bool) {
+	if vm.onThread(func() { ok = vm.HasModule(module) }) {
+		return ok
+	}
 	if vm.vm == nil {
 		return false
 	}
-	cModule := C.CString(module)
-	defer C.free(unsafe.Pointer(cModule))
+	defer vm.arena.release(vm.arena.mark())
+	cModule := vm.arena.cString(module)
 	return bool(vm.vm != nil && C.wrenHasModule(vm.vm, cModule))
 }
 
 // Abort stops the running Wren fiber and throws the error passed to it
 func (vm *VM) Abort(err error) {
-	C.wrenEnsureSlots(vm.vm, 1)
+	if vm.onThread(func() { vm.Abort(err) }) {
+		return
+	}
 	if err != nil {
-		vm.setSlotValue(err.Error(), 0)
+		vm.AbortValue(err.Error())
 	} else {
-		vm.setSlotValue("Fiber Aborted", 0)
+		vm.AbortValue(nil)
+	}
+}
+
+// AbortValue stops the running Wren fiber and throws `value`, which can be anything that can be passed to Wren such as a map or a foreign object. Scripts can get it back from `Fiber.try`. Since Wren doesn't abort on null, a nil value (or one that can't be passed to Wren) aborts with "Fiber Aborted" instead
+func (vm *VM) AbortValue(value interface{}) {
+	if vm.onThread(func() { vm.AbortValue(value) }) {
+		return
+	}
+	count(&vm.stats.Aborts)
+	base := vm.reserveSlots(1)
+	defer vm.releaseSlots(base)
+	if value == nil || vm.setSlotValue(value, base) != nil {
+		vm.setSlotValue("Fiber Aborted", base)
+	}
+	C.wrenAbortFiber(vm.vm, C.int(base))
+}
+
+// writeLines passes every line that `text` finishes to `Config.LineWriter`, holding on to the rest
+func (vm *VM) writeLines(text string) {
+	for {
+		i := strings.IndexByte(text, '\n')
+		if i < 0 {
+			break
+		}
+		line := vm.partialLine + text[:i]
+		vm.partialLine, text = "", text[i+1:]
+		vm.Config.LineWriter(vm, line)
+	}
+	vm.partialLine += text
+}
+
+// flushLine passes text that wasn't followed by a newline to `Config.LineWriter`
+func (vm *VM) flushLine() {
+	if vm.partialLine != "" && vm.Config.LineWriter != nil {
+		line := vm.partialLine
+		vm.partialLine = ""
+		vm.Config.LineWriter(vm, line)
 	}
-	C.wrenAbortFiber(vm.vm, 0)
 }
 
 //export writeFn
 func writeFn(v *C.WrenVM, text *C.char) {
 	var output io.Writer
-	unlocked := false
-	vmMapMux.RLock()
-	defer func() {
-		if !unlocked {
-			vmMapMux.RUnlock()
-		}
-	}()
-	if vm, ok := vmMap[v]; ok {
-		vmMapMux.RUnlock()
-		unlocked = true
+	if vm, ok := vmFromC(v); ok {
 		if vm.Config != nil {
+			if vm.Config.LineWriter != nil {
+				vm.writeLines(C.GoString(text))
+				return
+			}
 			if vm.Config.WriteFn != nil {
 				vm.Config.WriteFn(vm, C.GoString(text))
 				return
@@ -932,7 +1874,6 @@ func writeFn(v *C.WrenVM, text *C.char) {
 
 //export errorFn
 func errorFn(v *C.WrenVM, errorType C.WrenErrorType, module *C.char, line C.int, message *C.char) {
-	var output io.Writer
 	var err error
 	switch errorType {
 	case C.WREN_ERROR_COMPILE:
@@ -942,82 +1883,72 @@ func errorFn(v *C.WrenVM, errorType C.WrenErrorType, module *C.char, line C.int,
 	case C.WREN_ERROR_STACK_TRACE:
 		err = &StackTrace{module: C.GoString(module), line: int(line), message: C.GoString(message)}
 	}
-	unlocked := false
-	vmMapMux.RLock()
-	defer func() {
-		if !unlocked {
-			vmMapMux.RUnlock()
+	if vm, ok := vmFromC(v); ok {
+		if runtimeErr, ok := err.(*RuntimeError); ok {
+			runtimeErr.value = vm.abortValue(runtimeErr.message)
 		}
-	}()
-	if vm, ok := vmMap[v]; ok {
-		vmMapMux.RUnlock()
-		unlocked = true
-		if vm.Config != nil {
-			if vm.Config.ErrorFn != nil {
-				vm.Config.ErrorFn(vm, err)
-				return
-			}
-			if vm.Config.DefaultError != nil {
-				output = vm.Config.DefaultError
-			}
+		if vm.running {
+			vm.reported = append(vm.reported, err)
 		}
-		if DefaultError != nil {
-			output = DefaultError
+		vm.sendError(err)
+	}
+}
+
+// sendError passes `err` to the config's `ErrorFn` or writes it to the error output
+func (vm *VM) sendError(err error) {
+	var output io.Writer
+	if vm.Config != nil {
+		if vm.Config.ErrorFn != nil {
+			vm.Config.ErrorFn(vm, err)
+			return
 		}
-		if output != nil {
-			io.WriteString(output, err.Error()+"\n")
+		if vm.Config.DefaultError != nil {
+			output = vm.Config.DefaultError
 		}
 	}
+	if output == nil && DefaultError != nil {
+		output = DefaultError
+	}
+	if output != nil {
+		io.WriteString(output, err.Error()+"\n")
+	}
 }
 
 //export resolveModuleFn
 func resolveModuleFn(v *C.WrenVM, importer *C.char, name *C.char) *C.char {
-	unlocked := false
-	vmMapMux.RLock()
-	defer func() {
-		if !unlocked {
-			vmMapMux.RUnlock()
-		}
-	}()
-	if vm, ok := vmMap[v]; ok {
-		vmMapMux.RUnlock()
-		unlocked = true
+	if vm, ok := vmFromC(v); ok {
 		var (
-			newName string
-			ok      bool
+			newName = C.GoString(name)
+			ok      = true
 		)
 		if vm.Config != nil && vm.Config.ResolveModuleFn != nil {
 			newName, ok = vm.Config.ResolveModuleFn(vm, C.GoString(importer), C.GoString(name))
-		} else {
-			return name
+		} else if DefaultModuleResolver != nil {
+			newName, ok = DefaultModuleResolver(vm, C.GoString(importer), C.GoString(name))
 		}
 		if ok {
-			return C.CString(newName)
+			if err := vm.checkImport(C.GoString(importer), newName, vm.HasModule(newName)); err != nil {
+				vm.sendError(err)
+				ok = false
+			}
 		}
-		return nil
+		vm.auditImport(C.GoString(importer), newName, ok)
+		if !ok {
+			return nil
+		}
+		if newName == C.GoString(name) {
+			return name
+		}
+		// Wren frees this with its own allocator
+		return vm.heapCString(newName)
 	}
 	return name
 }
 
 //export moduleLoaderFn
 func moduleLoaderFn(v *C.WrenVM, name *C.char) C.WrenLoadModuleResult {
-	unlocked := false
-	vmMapMux.RLock()
-	defer func() {
-		if !unlocked {
-			vmMapMux.RUnlock()
-		}
-	}()
-	if vm, ok := vmMap[v]; ok {
-		vmMapMux.RUnlock()
-		unlocked = true
-		var source string
-		if vm.Config != nil && vm.Config.LoadModuleFn != nil {
-			source, ok = vm.Config.LoadModuleFn(vm, C.GoString(name))
-		} else if DefaultModuleLoader != nil {
-			source, ok = DefaultModuleLoader(vm, C.GoString(name))
-		}
-		if ok {
+	if vm, ok := vmFromC(v); ok {
+		if source, ok := vm.loadModule(C.GoString(name)); ok {
 			return C.WrenLoadModuleResult{
 				source:     C.CString(source),
 				onComplete: C.WrenLoadModuleCompleteFn(C.loadModuleCompleteFn),
@@ -1034,6 +1965,74 @@ func moduleLoaderFn(v *C.WrenVM, name *C.char) C.WrenLoadModuleResult {
 	}
 }
 
+// ResolveRelative resolves module names starting with "./" or "../" relative to the directory of the importing module, so `import "./utils"` from "lib/main" becomes "lib/utils". Names that would climb above the top module directory, like "../utils" from "main", can't be resolved. Other names are returned unchanged. It is the default `ResolveModuleFn`
+func ResolveRelative(vm *VM, importer, name string) (string, bool) {
+	if !strings.HasPrefix(name, "./") && !strings.HasPrefix(name, "../") {
+		return name, true
+	}
+	resolved := path.Join(path.Dir(importer), name)
+	if resolved == ".." || strings.HasPrefix(resolved, "../") {
+		return "", false
+	}
+	return resolved, true
+}
+
+// loadModule finds the source for an imported module. Modules set with `SetModule` that have `Source` (or `Declare`) come first, then Wren's optional modules, then optional modules registered with `RegisterOptionalModule`, then the config's `ModuleProviderFn`, and finally the config's `LoadModuleFn` (or `DefaultModuleLoader`)
+func (vm *VM) loadModule(name string) (string, bool) {
+	if module, ok := vm.moduleMap[name]; ok && (module.Source != "" || module.Declare) {
+		return module.source(), true
+	}
+	if source, ok := vm.loadBuiltinModule(name); ok {
+		return source, true
+	}
+	if definition, ok := goModules[name]; ok {
+		module := definition()
+		vm.setModule(name, module)
+		return module.source(), true
+	}
+	if optional, ok := lookupOptionalModule(name); ok {
+		if !vm.HasCapability(optional.capability) {
+			vm.sendError(&CapabilityDenied{Module: name, Capability: optional.capability})
+			return "", false
+		}
+		vm.setModule(name, optional.module)
+		return optional.module.source(), true
+	}
+	if vm.Config != nil && vm.Config.ModuleProviderFn != nil {
+		if module, ok := vm.Config.ModuleProviderFn(vm, name); ok && module != nil {
+			vm.setModule(name, module)
+			return module.source(), true
+		}
+	}
+	if vm.Config != nil && vm.Config.LoadModuleFn != nil {
+		return vm.Config.LoadModuleFn(vm, name)
+	} else if DefaultModuleLoader != nil {
+		return DefaultModuleLoader(vm, name)
+	}
+	return "", false
+}
+
+//export reallocateFn
+func reallocateFn(h unsafe.Pointer, oldSize, newSize C.size_t) {
+	vm, ok := (*heap)(h).owner()
+	if ok && vm.Config != nil && vm.Config.ReallocateFn != nil {
+		vm.Config.ReallocateFn(vm, int(oldSize), int(newSize))
+	}
+}
+
+//export checkpointFn
+func checkpointFn(h unsafe.Pointer) *C.char {
+	vm, ok := (*heap)(h).owner()
+	if !ok {
+		return nil
+	}
+	if err := vm.checkpoint(); err != nil {
+		// wren.c frees the message
+		return C.CString(err.Error())
+	}
+	return nil
+}
+
 //export loadModuleCompleteFn
 func loadModuleCompleteFn(vm *C.WrenVM, name *C.char, res C.WrenLoadModuleResult) {
 	C.free(unsafe.Pointer(res.source))
@@ -1042,43 +2041,71 @@ func loadModuleCompleteFn(vm *C.WrenVM, name *C.char, res C.WrenLoadModuleResult
 
 //export bindForeignMethodFn
 func bindForeignMethodFn(v *C.WrenVM, cModule *C.char, cClassName *C.char, cIsStatic C.bool, cSignature *C.char) C.WrenForeignMethodFn {
-	unlocked := false
-	vmMapMux.RLock()
-	defer func() {
-		if !unlocked {
-			vmMapMux.RUnlock()
-		}
-	}()
-	if vm, ok := vmMap[v]; ok {
-		vmMapMux.RUnlock()
-		unlocked = true
-		if module, ok := vm.moduleMap[C.GoString(cModule)]; ok {
-			if class, ok := module.ClassMap[C.GoString(cClassName)]; ok {
-				var name string
-				if bool(cIsStatic) {
-					name = "static " + C.GoString(cSignature)
-				} else {
-					name = C.GoString(cSignature)
-				}
-				if fn, ok := class.MethodMap[name]; ok {
-					foreignMethod, err := vm.registerFunc(fn)
-					if err != nil {
-						panic(err.Error())
-					}
-					return foreignMethod
-				}
+	if vm, ok := vmFromC(v); ok {
+		key := methodKey{module: C.GoString(cModule), class: C.GoString(cClassName), signature: C.GoString(cSignature), static: bool(cIsStatic)}
+		if fn, name, ok := vm.lookupMethod(key); ok {
+			foreignMethod, err := vm.registerFunc(vm.instrument(key.module, key.class, name, fn))
+			if err != nil {
+				vm.sendError(err)
+				return nil
 			}
+			vm.bound[key] = len(vm.bindMap) - 1
+			return foreignMethod
 		}
 	}
 	return nil
 }
 
+// recoverForeign turns a panic in a foreign method or finalizer into a `ForeignPanic` and passes it to `Config.PanicHandler`. It must be deferred directly
+func (vm *VM) recoverForeign(err *error) {
+	value := recover()
+	if value == nil {
+		return
+	}
+	panicErr := &ForeignPanic{Value: value, Stack: debug.Stack()}
+	if vm.Config.PanicHandler != nil {
+		vm.Config.PanicHandler(vm, panicErr)
+	}
+	if err != nil {
+		*err = panicErr
+	}
+}
+
+// runForeign calls a foreign method, recovering if it panics
+func (vm *VM) runForeign(fn ForeignMethodFn, parameters []interface{}) (ret interface{}, err error) {
+	defer vm.recoverForeign(&err)
+	return fn(vm, parameters)
+}
+
 type foreignInstance struct {
 	finalizer ForeignFinalizer
-	vm        *VM
 	value     interface{}
 }
 
+// foreignData is what WrenGo writes into the bytes of the foreign objects it creates. Go values can't be kept in memory Wren allocates, so they are kept in the VM's `foreigns` under `id` instead
+type foreignData struct {
+	vm cgo.Handle
+	id uint64
+}
+
+// newForeign creates a foreign object in `slot` from the class in `classSlot` that holds `foreign`
+func (vm *VM) newForeign(slot, classSlot int, foreign foreignInstance) {
+	data := (*foreignData)(C.wrenSetSlotNewForeign(vm.vm, C.int(slot), C.int(classSlot), C.size_t(unsafe.Sizeof(foreignData{}))))
+	vm.lastForeign++
+	*data = foreignData{vm: vm.self, id: vm.lastForeign}
+	vm.foreigns[vm.lastForeign] = foreign
+}
+
+// foreignValue finds what the foreign object with the bytes at `ptr` holds. Foreign objects that WrenGo didn't create (or that another VM created) aren't found
+func (vm *VM) foreignValue(ptr unsafe.Pointer) (foreignInstance, bool) {
+	data := (*foreignData)(ptr)
+	if data == nil || data.vm != vm.self {
+		return foreignInstance{}, false
+	}
+	foreign, ok := vm.foreigns[data.id]
+	return foreign, ok
+}
+
 //export invalidConstructor
 func invalidConstructor(v *C.WrenVM) {
 	C.wrenEnsureSlots(v, 1)
@@ -1090,19 +2117,20 @@ func invalidConstructor(v *C.WrenVM) {
 
 //export bindForeignClassFn
 func bindForeignClassFn(v *C.WrenVM, cModule *C.char, cClassName *C.char) C.WrenForeignClassMethods {
-	unlocked := false
-	vmMapMux.RLock()
-	defer func() {
-		if !unlocked {
-			vmMapMux.RUnlock()
+	if vm, ok := vmFromC(v); ok {
+		if err := vm.checkForeignClass(C.GoString(cModule), C.GoString(cClassName)); err != nil {
+			denied, regErr := vm.registerFunc(func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return nil, err
+			})
+			if regErr != nil {
+				vm.sendError(regErr)
+				denied = C.WrenForeignMethodFn(C.invalidConstructor)
+			}
+			return C.WrenForeignClassMethods{allocate: denied}
 		}
-	}()
-	if vm, ok := vmMap[v]; ok {
-		vmMapMux.RUnlock()
-		unlocked = true
 		if module, ok := vm.moduleMap[C.GoString(cModule)]; ok {
 			if class, ok := module.ClassMap[C.GoString(cClassName)]; ok {
-				initializer, err := vm.registerFunc(
+				initializer, err := vm.registerFunc(vm.instrument(C.GoString(cModule), C.GoString(cClassName), "<allocate>",
 					func(vm *VM, parameters []interface{}) (interface{}, error) {
 						var (
 							foreign interface{}
@@ -1114,19 +2142,15 @@ func bindForeignClassFn(v *C.WrenVM, cModule *C.char, cClassName *C.char) C.Wren
 						if err != nil {
 							return nil, err
 						}
-						ptr := C.wrenSetSlotNewForeign(vm.vm, 0, 0, 1)
-						foreignMapMux.Lock()
-						defer foreignMapMux.Unlock()
-						foreignMap[ptr] = foreignInstance{
-							finalizer: class.Finalizer,
-							vm:        vm,
-							value:     foreign,
-						}
+						vm.newForeign(0, 0, foreignInstance{finalizer: class.Finalizer, value: foreign})
 						return nil, nil
 					},
-				)
+				))
 				if err != nil {
-					panic(err.Error())
+					vm.sendError(err)
+					return C.WrenForeignClassMethods{
+						allocate: C.WrenForeignMethodFn(C.invalidConstructor),
+					}
 				}
 				return C.WrenForeignClassMethods{
 					finalize: C.WrenFinalizerFn(C.foreignFinalizerFn),
@@ -1135,7 +2159,8 @@ func bindForeignClassFn(v *C.WrenVM, cModule *C.char, cClassName *C.char) C.Wren
 			}
 		}
 	}
-	if C.GoString(cModule) == "random" {
+	if isBuiltinModule(C.GoString(cModule)) {
+		// Wren binds the classes of its own modules
 		return C.WrenForeignClassMethods{
 			allocate: nil,
 			finalize: nil,
@@ -1148,19 +2173,16 @@ func bindForeignClassFn(v *C.WrenVM, cModule *C.char, cClassName *C.char) C.Wren
 
 //export foreignFinalizerFn
 func foreignFinalizerFn(ptr unsafe.Pointer) {
-	unlocked := false
-	foreignMapMux.RLock()
-	defer func() {
-		if !unlocked {
-			foreignMapMux.RUnlock()
-		}
-	}()
-	if foreign, ok := foreignMap[ptr]; ok {
-		foreignMapMux.RUnlock()
-		unlocked = true
+	data := (*foreignData)(ptr)
+	vm, ok := data.vm.Value().(*VM)
+	if !ok {
+		return
+	}
+	if foreign, ok := vm.foreigns[data.id]; ok {
+		delete(vm.foreigns, data.id)
 		if foreign.finalizer != nil {
-			foreign.finalizer(foreign.vm, foreign.value)
+			defer vm.recoverForeign(nil)
+			foreign.finalizer(vm, foreign.value)
 		}
-		delete(foreignMap, ptr)
 	}
 }
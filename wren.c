@@ -8878,6 +8878,38 @@ void wrenDebugPrintStackTrace(WrenVM* vm)
   }
 }
 
+// WrenGo: Returns how many frames the running fiber has, so the embedder can
+// read its call stack.
+int wrengoFrameCount(WrenVM* vm)
+{
+  return vm->fiber == NULL ? 0 : vm->fiber->numFrames;
+}
+
+// WrenGo: Reads the frame [index] frames below the top of the running fiber's
+// stack like [wrenDebugPrintStackTrace] does. Frames of the core module and of
+// calls from the API have no module and return false. The line of the top
+// frame is 0 since its IP is only stored when it calls another method.
+bool wrengoFrame(WrenVM* vm, int index, const char** module, int* line,
+                 const char** name)
+{
+  ObjFiber* fiber = vm->fiber;
+  CallFrame* frame = &fiber->frames[fiber->numFrames - 1 - index];
+  ObjFn* fn = frame->closure->fn;
+  if (fn->module == NULL || fn->module->name == NULL) return false;
+
+  *module = fn->module->name->value;
+  *name = fn->debug->name;
+  *line = 0;
+
+  // -1 because IP has advanced past the instruction that made the call.
+  long i = (long)(frame->ip - fn->code.data) - 1;
+  if (index > 0 && i >= 0 && i < fn->debug->sourceLines.count)
+  {
+    *line = fn->debug->sourceLines.data[i];
+  }
+  return true;
+}
+
 static void dumpObject(Obj* obj)
 {
   switch (obj->type)
@@ -11343,6 +11375,35 @@ static void runtimeError(WrenVM* vm)
   vm->apiStack = NULL;
 }
 
+// WrenGo: Called by the interpreter on every backward jump and method call, so
+// the embedder can stop a script even if it never calls a foreign method.
+// Returns NULL to keep running or an error message, allocated with malloc(),
+// to stop the script with.
+extern char* wrengoCheckpoint(WrenVM* vm);
+
+// WrenGo: Aborts the current fiber and every fiber that called it with
+// [message], then frees [message]. Unlike [runtimeError], fibers run with
+// "try" don't catch the error, so a script that was stopped can't keep going.
+static void wrengoAbort(WrenVM* vm, char* message)
+{
+  vm->fiber->error = wrenNewString(vm, message);
+  free(message);
+
+  Value error = vm->fiber->error;
+  ObjFiber* current = vm->fiber;
+  while (current != NULL)
+  {
+    current->error = error;
+    ObjFiber* caller = current->caller;
+    current->caller = NULL;
+    current = caller;
+  }
+
+  wrenDebugPrintStackTrace(vm);
+  vm->fiber = NULL;
+  vm->apiStack = NULL;
+}
+
 // Aborts the current fiber with an appropriate method not found error for a
 // method with [symbol] on [classObj].
 static void methodNotFound(WrenVM* vm, ObjClass* classObj, int symbol)
@@ -11785,6 +11846,20 @@ static WrenInterpretResult runInterpreter(WrenVM* vm, register ObjFiber* fiber)
         DISPATCH();                                                            \
       } while (false)
 
+  // WrenGo: Lets the embedder stop the script. Every loop and recursion passes
+  // through it, since it is used on backward jumps and method calls.
+  #define CHECKPOINT()                                                         \
+      do                                                                       \
+      {                                                                        \
+        STORE_FRAME();                                                         \
+        char* checkpointError = wrengoCheckpoint(vm);                          \
+        if (checkpointError != NULL)                                           \
+        {                                                                      \
+          wrengoAbort(vm, checkpointError);                                    \
+          return WREN_RESULT_RUNTIME_ERROR;                                    \
+        }                                                                      \
+      } while (false)
+
   #if WREN_DEBUG_TRACE_INSTRUCTIONS
     // Prints the stack and instruction before each instruction is executed.
     #define DEBUG_TRACE_INSTRUCTIONS()                                         \
@@ -12162,6 +12237,8 @@ OPCODE(END, 0)
       goto completeCall;
 
     completeCall:
+      CHECKPOINT();
+
       // If the class's method table doesn't include the symbol, bail.
       if (symbol >= classObj->methods.count ||
           (method = &classObj->methods.data[symbol])->type == METHOD_NONE)
@@ -12285,6 +12362,7 @@ OPCODE(END, 0)
     {
       // Jump back to the top of the loop.
       uint16_t offset = READ_SHORT();
+      CHECKPOINT();
       ip -= offset;
       DISPATCH();
     }
@@ -12619,6 +12697,22 @@ WrenHandle* wrenMakeHandle(WrenVM* vm, Value value)
   return handle;
 }
 
+// WrenGo: Returns whether the last interpretation or call was suspended with
+// Fiber.suspend(), which leaves the VM without a fiber until it is resumed.
+bool wrengoSuspended(WrenVM* vm)
+{
+  return vm->fiber == NULL;
+}
+
+// WrenGo: Makes a handle to the value the running fiber aborted with, or
+// returns NULL if there is no fiber. This only reads the fiber's error so it is
+// safe to call while a runtime error is being reported.
+WrenHandle* wrengoFiberError(WrenVM* vm)
+{
+  if (vm->fiber == NULL) return NULL;
+  return wrenMakeHandle(vm, vm->fiber->error);
+}
+
 void wrenReleaseHandle(WrenVM* vm, WrenHandle* handle)
 {
   ASSERT(handle != NULL, "Handle cannot be NULL.");
@@ -12638,6 +12732,24 @@ void wrenReleaseHandle(WrenVM* vm, WrenHandle* handle)
   DEALLOCATE(vm, handle);
 }
 
+// WrenGo: Returns the number the range in [handle] starts at.
+double wrengoRangeFrom(WrenHandle* handle)
+{
+  return AS_RANGE(handle->value)->from;
+}
+
+// WrenGo: Returns the number the range in [handle] ends at.
+double wrengoRangeTo(WrenHandle* handle)
+{
+  return AS_RANGE(handle->value)->to;
+}
+
+// WrenGo: Returns whether the end of the range in [handle] is part of it.
+bool wrengoRangeIsInclusive(WrenHandle* handle)
+{
+  return AS_RANGE(handle->value)->isInclusive;
+}
+
 WrenInterpretResult wrenInterpret(WrenVM* vm, const char* module,
                                   const char* source)
 {
@@ -12753,6 +12865,12 @@ void wrenPopRoot(WrenVM* vm)
   vm->numTempRoots--;
 }
 
+// WrenGo: Returns whether [handle] holds a foreign class.
+bool wrengoClassIsForeign(WrenHandle* handle)
+{
+  return IS_CLASS(handle->value) && AS_CLASS(handle->value)->numFields == -1;
+}
+
 int wrenGetSlotCount(WrenVM* vm)
 {
   if (vm->apiStack == NULL) return 0;
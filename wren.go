@@ -9,7 +9,7 @@ package wren
 import "C"
 
 //go:generate go run getWren.go
-//go:generate go run createBindings.go -bindings 512
+//go:generate go run createBindings.go -bindings 512 -chunk 128
 
 const (
 	// VersionString Wren's version as a string
@@ -1,9 +1,22 @@
 package wren
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
+	"testing/fstest"
+	"time"
 )
 
 func createConfig(t *testing.T) *Config {
@@ -472,3 +485,4061 @@ func TestNoReentry(t *testing.T) {
 	GoFoo.reEntryByMethod()
 	`)
 }
+
+func TestInterpretFileFS(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	fsys := fstest.MapFS{
+		"scripts/main.wren": &fstest.MapFile{Data: []byte("#!/usr/bin/env wrengo\nvar value = \"from fs\"\n")},
+	}
+	if err := vm.InterpretFileFS(fsys, "scripts/main.wren"); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if val, _ := vm.GetVariable("scripts/main.wren", "value"); val != "from fs" {
+		t.Errorf("Expected \"from fs\" but got \"%v\"", val)
+	}
+	if err := vm.InterpretFileFS(fsys, "missing.wren"); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestInterpretMore(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	if err := vm.InterpretMore("repl", `var a = 1`); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if err := vm.InterpretMore("repl", `
+	class Adder {
+		static add(x, y) { x + y }
+	}
+	var b = Adder.add(a, "%(a)".count)`); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if b, _ := vm.GetVariable("repl", "b"); b != 2.0 {
+		t.Errorf("Expected b to be 2 but got %v", b)
+	}
+	err := vm.InterpretMore("repl", `
+	a = 5
+	var a = 3`)
+	var redefined *VariableRedefined
+	if !errors.As(err, &redefined) {
+		t.Errorf("Expected VariableRedefined but got %v", err)
+		return
+	}
+	if redefined.Name != "a" || redefined.Line != 3 {
+		t.Errorf("Unexpected redefinition %v", redefined)
+	}
+	if a, _ := vm.GetVariable("repl", "a"); a != 1.0 {
+		t.Errorf("Source should not have run but a is %v", a)
+	}
+}
+
+func TestStdinModule(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.DefaultInput = strings.NewReader("first line\r\nsecond line\nthe rest\nof the input")
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("io", NewStdinModule())
+	err := vm.InterpretString("main", `
+	import "io" for Stdin
+	var first = Stdin.readLine()
+	var second = Stdin.readLine()
+	var rest = Stdin.readAll()
+	var done = Stdin.readLine()
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if err := vm.Run(); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"first": "first line", "second": "second line", "rest": "the rest\nof the input", "done": nil}
+	for name, value := range expected {
+		if v, _ := vm.GetVariable("main", name); v != value {
+			t.Errorf("Expected %v to be %q but got %q", name, value, v)
+		}
+	}
+}
+
+func TestPreludes(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.Preludes = []Prelude{
+		{Module: "helpers", Source: `
+		class Helpers {
+			static greet(name) { "Hello, %(name)!" }
+		}`},
+		{Module: "main", Source: `import "helpers" for Helpers`},
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `var greeting = Helpers.greet("WrenGo")`); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if greeting, _ := vm.GetVariable("main", "greeting"); greeting != "Hello, WrenGo!" {
+		t.Errorf("Unexpected greeting %v", greeting)
+	}
+
+	var preludeErr *PreludeError
+	cfg.Preludes = []Prelude{{Module: "broken", Source: `var = 1`}, {Module: "never", Source: `var x = 1`}}
+	cfg.ErrorFn = func(vm *VM, err error) {
+		if e, ok := err.(*PreludeError); ok {
+			preludeErr = e
+		}
+	}
+	vm2 := cfg.NewVM()
+	defer vm2.Free()
+	if preludeErr == nil || preludeErr.Module != "broken" {
+		t.Errorf("Expected a PreludeError for module \"broken\" but got %v", preludeErr)
+	}
+	if vm2.HasModule("never") {
+		t.Error("Preludes after a failing prelude should not run")
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, data := range files {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(data), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestProject(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"wren.mod": `
+		// A test project
+		entry main
+		root src
+		root lib
+		alias text => vendor/text
+		deny secret`,
+		"src/main.wren":        "#!/usr/bin/env wrengo\nimport \"util\" for Util\nimport \"text\" for Text\nvar result = Util.double(Text.count)",
+		"lib/util.wren":        "class Util {\n static double(x) { x * 2 }\n}",
+		"lib/vendor/text.wren": "class Text {\n static count { 21 }\n}",
+		"src/secret.wren":      "var secret = 42",
+	})
+	project, err := LoadProject(dir)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	cfg := project.Configure(createConfig(t))
+	var denied *ImportDenied
+	cfg.ErrorFn = func(vm *VM, err error) {
+		errors.As(err, &denied)
+		t.Logf("error> %v", err)
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := project.Run(vm); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if result, _ := vm.GetVariable("main", "result"); result != 42.0 {
+		t.Errorf("Expected result to be 42 but got %v", result)
+	}
+	if err := vm.InterpretString("other", `import "secret"`); err == nil || denied == nil {
+		t.Error("Expected importing \"secret\" to be denied")
+	}
+	if file, ok := project.FindModule("../wren.mod"); ok {
+		t.Errorf("Expected modules outside of the roots not to be found but found %v", file)
+	}
+	if _, ok := project.FindModule("vendor/../util"); !ok {
+		t.Error("Expected a name that stays inside a root to be found")
+	}
+
+	if _, err := ParseProject("wren.mod", []byte("entry main\nalias broken")); err == nil {
+		t.Error("Expected malformed alias to fail")
+	} else if manifestErr, ok := err.(*ManifestError); !ok || manifestErr.Line != 2 {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestProjectFetch(t *testing.T) {
+	source := "class Remote {\n static value { \"fetched\" }\n}"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(source))
+	}))
+	defer server.Close()
+	dir := t.TempDir()
+	writeFiles(t, dir, map[string]string{
+		"wren.mod":  "entry main\ncache cache\nrequire remote " + server.URL + "/remote.wren",
+		"main.wren": "import \"remote\" for Remote\nvar value = Remote.value",
+	})
+	project, err := LoadProject(dir)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	vm := project.Configure(createConfig(t)).NewVM()
+	if err := project.Run(vm); err == nil {
+		t.Error("Expected the entry to fail before fetching")
+	}
+	vm.Free()
+	if err := project.Fetch(); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	vm = project.Configure(createConfig(t)).NewVM()
+	defer vm.Free()
+	if err := project.Run(vm); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if value, _ := vm.GetVariable("main", "value"); value != "fetched" {
+		t.Errorf("Unexpected value %v", value)
+	}
+	// Changing the remote module should not match the lockfile anymore
+	source = "class Remote {}"
+	os.RemoveAll(filepath.Join(dir, "cache"))
+	var mismatch *ChecksumMismatch
+	if err := project.Fetch(); !errors.As(err, &mismatch) {
+		t.Errorf("Expected ChecksumMismatch but got %v", err)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	RegisterOptionalModule("test-secrets", CapabilityOS, &Module{
+		ClassMap: ClassMap{
+			"Secrets": NewClass(nil, nil, MethodMap{
+				"static value": func(vm *VM, parameters []interface{}) (interface{}, error) {
+					return "hunter2", nil
+				},
+			}),
+		},
+		Source: "class Secrets {\n foreign static value\n}",
+	})
+	defer UnregisterOptionalModule("test-secrets")
+	script := `
+	import "test-secrets" for Secrets
+	var value = Secrets.value`
+
+	cfg := createConfig(t)
+	var denied *CapabilityDenied
+	cfg.ErrorFn = func(vm *VM, err error) {
+		errors.As(err, &denied)
+		t.Logf("error> %v", err)
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", script); err == nil {
+		t.Error("Expected import without capability to fail")
+	}
+	if denied == nil || denied.Capability != CapabilityOS {
+		t.Errorf("Expected CapabilityDenied error but got %v", denied)
+	}
+
+	cfg.Capabilities = []Capability{CapabilityOS}
+	vm2 := cfg.NewVM()
+	defer vm2.Free()
+	if err := vm2.InterpretString("main", script); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if value, _ := vm2.GetVariable("main", "value"); value != "hunter2" {
+		t.Errorf("Unexpected value %v", value)
+	}
+}
+
+func TestAuditSink(t *testing.T) {
+	var log bytes.Buffer
+	cfg := createConfig(t)
+	cfg.Name = "tenant-1"
+	cfg.AuditSink = NewJSONAuditSink(&log)
+	cfg.LoadModuleFn = func(vm *VM, name string) (string, bool) {
+		return "", name == "empty"
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Greeter": NewClass(nil, nil, MethodMap{
+			"static greet(_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	import "empty"
+	class Greeter {
+		foreign static greet(name, times)
+	}
+	Greeter.greet("a very long name that should definitely be truncated by the audit log because it is long", 3)
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	var events []AuditEvent
+	decoder := json.NewDecoder(&log)
+	for decoder.More() {
+		var event AuditEvent
+		if err := decoder.Decode(&event); err != nil {
+			t.Error(err.Error())
+			return
+		}
+		events = append(events, event)
+	}
+	if len(events) != 2 {
+		t.Errorf("Expected 2 audit events but got %v", len(events))
+		return
+	}
+	if events[0].Kind != AuditImport || events[0].Module != "empty" || events[0].Importer != "main" || events[0].VM != "tenant-1" {
+		t.Errorf("Unexpected import event %+v", events[0])
+	}
+	call := events[1]
+	if call.Kind != AuditForeignCall || call.Class != "Greeter" || call.Signature != "static greet(_,_)" || len(call.Args) != 2 || call.Args[1] != "3" || len(call.Args[0]) != auditArgLimit+3 {
+		t.Errorf("Unexpected call event %+v", call)
+	}
+}
+
+func TestSlotStaging(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var received []interface{}
+	vm.SetModule("main", NewModule(ClassMap{
+		"Echo": NewClass(nil, nil, MethodMap{
+			"static receive(_,_,_,_,_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				received = parameters[1:]
+				return parameters[2], nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Echo {
+		foreign static receive(a, b, c, d, e, f)
+	}
+	class Caller {
+		static call(a, b, c, d, e, f) { Echo.receive(a, b, c, d, e, f) }
+	}`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	value, _ := vm.GetVariable("main", "Caller")
+	caller := value.(*Handle)
+	defer caller.Free()
+	fn, _ := caller.Func("call(_,_,_,_,_,_)")
+	defer fn.Free()
+	result, err := fn.Call("first", []byte("sec\x00ond"), 3, true, Null, "")
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	expected := []interface{}{"first", "sec\x00ond", 3.0, true, nil, ""}
+	if !reflect.DeepEqual(received, expected) {
+		t.Errorf("Expected %q but got %q", expected, received)
+	}
+	if result != "sec\x00ond" {
+		t.Errorf("Unexpected result %q", result)
+	}
+	if _, err := fn.Call(1, 2, 3, 4, 5, struct{}{}); err == nil {
+		t.Error("Expected an error for an invalid value")
+	}
+}
+
+func TestArena(t *testing.T) {
+	var a arena
+	defer a.free()
+	outer := a.mark()
+	a.cString("module")
+	inner := a.mark()
+	a.cString(strings.Repeat("x", arenaChunkSize*2))
+	if len(a.chunks) != 2 {
+		t.Errorf("Expected large string to get its own chunk but arena has %v chunks", len(a.chunks))
+	}
+	a.release(inner)
+	if len(a.chunks) != 1 || a.chunks[0].used != len("module")+1 {
+		t.Errorf("Unexpected arena state after release %+v", a.chunks)
+	}
+	a.release(outer)
+	if len(a.chunks) != 1 || a.chunks[0].used != 0 {
+		t.Errorf("Expected first chunk to be kept for reuse %+v", a.chunks)
+	}
+
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.InterpretString("main", `var value = "%("x" * 8192)"`)
+	if !vm.HasVariable("main", "value") || vm.HasVariable("main", "missing") || vm.HasVariable("missing", "value") {
+		t.Error("HasVariable returned unexpected results")
+	}
+	for _, chunk := range vm.arena.chunks {
+		if chunk.used != 0 {
+			t.Errorf("Expected arena to be released after interpreting %+v", vm.arena.chunks)
+		}
+	}
+}
+
+func TestValueEqual(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	var list = [1, "two", true, null, [3, 4]]
+	var map = {"a": 1, "b": [2, 3], 4: {"nested": true}}
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	list, _ := vm.GetVariable("main", "list")
+	m, _ := vm.GetVariable("main", "map")
+	defer vm.FreeAll(list, m)
+	cases := []struct {
+		a, b     interface{}
+		expected bool
+	}{
+		{list, []interface{}{1, "two", true, nil, []int{3, 4}}, true},
+		{[]interface{}{1.0, []byte("two"), true, Null, [2]float32{3, 4}}, list, true},
+		{list, []interface{}{1, "two", true, nil, []int{3, 5}}, false},
+		{list, []interface{}{1, "two", true, nil}, false},
+		{list, list, true},
+		{list, "not a list", false},
+		{m, map[interface{}]interface{}{"a": 1, "b": []int{2, 3}, 4: map[string]bool{"nested": true}}, true},
+		{m, map[interface{}]interface{}{"a": 1, "b": []int{2, 3}, 4: map[string]bool{"nested": false}}, false},
+		{m, map[string]int{"a": 1}, false},
+		{m, m, true},
+		{int8(3), 3.0, true},
+		{map[int]string{1: "a"}, map[float64]string{1: "a"}, true},
+	}
+	for i, c := range cases {
+		equal, err := ValueEqual(c.a, c.b)
+		if err != nil {
+			t.Errorf("Case %v returned error: %v", i, err)
+		} else if equal != c.expected {
+			t.Errorf("Case %v expected %v but got %v", i, c.expected, equal)
+		}
+	}
+	if _, err := ValueEqual(list, struct{}{}); err != nil {
+		t.Errorf("Comparing a list to a struct should be false, not %v", err)
+	}
+	if _, err := ValueEqual(struct{}{}, 1); err == nil {
+		t.Error("Expected an error comparing a struct")
+	}
+}
+
+func TestCallStatic(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	class Calc {
+		static add(a, b) { a + b }
+		static name { "calc" }
+	}
+	var notAClass = 3
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	for i := 0; i < 2; i++ {
+		value, err := vm.CallStatic("main", "Calc", "add(_,_)", i, 2)
+		if err != nil {
+			t.Error(err.Error())
+		} else if value != float64(i+2) {
+			t.Errorf("Expected %v but got %v", i+2, value)
+		}
+	}
+	if len(vm.calls) != 1 {
+		t.Errorf("Expected the call handle to be cached once but there are %v", len(vm.calls))
+	}
+	before := len(vm.handles)
+	for _, fn := range vm.calls {
+		fn.Free()
+	}
+	if len(vm.calls) != 0 || len(vm.handles) != before-2 {
+		t.Errorf("Expected freeing the call handle to release its receiver and leave the cache, got %v cached and %v handles", len(vm.calls), len(vm.handles)-before)
+	}
+	if value, err := vm.CallStatic("main", "Calc", "add(_,_)", 1, 2); err != nil || value != 3.0 {
+		t.Errorf("Expected the call handle to be made again but got %v (%v)", value, err)
+	}
+	if value, err := vm.CallStatic("main", "Calc", "static name"); err != nil {
+		t.Error(err.Error())
+	} else if value != "calc" {
+		t.Errorf("Expected \"calc\" but got %v", value)
+	}
+	if _, err := vm.CallStatic("main", "Missing", "add(_,_)", 1, 2); err == nil {
+		t.Error("Expected an error calling a missing class")
+	}
+	if _, err := vm.CallStatic("main", "notAClass", "add(_,_)", 1, 2); err == nil {
+		t.Error("Expected an error calling a number")
+	}
+}
+
+func TestSlotReservation(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var sum float64
+	vm.SetModule("main", NewModule(ClassMap{
+		"Summer": NewClass(nil, nil, MethodMap{
+			// Returns nil so the receiver in slot 0 is returned, which handles
+			// used here must not overwrite
+			"static sum(_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				list := parameters[1].(*ListHandle)
+				m := parameters[2].(*MapHandle)
+				count, _ := list.Count()
+				for i := 0; i < count; i++ {
+					value, err := list.Get(i)
+					if err != nil {
+						return nil, err
+					}
+					scale, err := m.Get("scale")
+					if err != nil {
+						return nil, err
+					}
+					sum += value.(float64) * scale.(float64)
+				}
+				name, err := vm.GetVariable("main", "name")
+				if err != nil || name != "summer" {
+					return nil, fmt.Errorf("unexpected name %v (%v)", name, err)
+				}
+				return nil, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	var name = "summer"
+	class Summer {
+		foreign static sum(list, map)
+	}
+	var result = Summer.sum([1, 2, 3, 4], {"scale": 2})
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if sum != 20 {
+		t.Errorf("Expected a sum of 20 but got %v", sum)
+	}
+	result, _ := vm.GetVariable("main", "result")
+	defer vm.FreeAll(result)
+	if _, ok := result.(*Handle); !ok {
+		t.Errorf("Expected the Summer class to be returned but got %v", result)
+	}
+	if vm.slotTop != 0 {
+		t.Errorf("Expected all slots to be released but %v are still reserved", vm.slotTop)
+	}
+}
+
+func TestHandleFreeForgets(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `var items = [1, 2]`); err != nil {
+		t.Fatal(err)
+	}
+	before := len(vm.handles)
+	items, err := VarAs[*ListHandle](vm, "main", "items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vm.handles) != before+1 {
+		t.Fatalf("Expected the handle to be kept by the VM but there are %v more", len(vm.handles)-before)
+	}
+	items.Free()
+	if len(vm.handles) != before {
+		t.Errorf("Expected the freed handle to be removed from the VM but there are %v more", len(vm.handles)-before)
+	}
+}
+
+func TestListGetCount(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var value interface{}
+	var err error
+	vm.SetModule("main", NewModule(ClassMap{
+		"Probe": NewClass(nil, nil, MethodMap{
+			// the short list is in slot 1, so the index of the long one must not be read as a slot
+			"static get(_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				value, err = parameters[2].(*ListHandle).Get(1)
+				return Null, nil
+			},
+		}),
+	}))
+	if err := vm.InterpretString("main", `
+	class Probe {
+		foreign static get(short, long)
+	}
+	Probe.get([1], [1, 2, 3])`); err != nil {
+		t.Fatal(err)
+	}
+	if err != nil || value != 2.0 {
+		t.Errorf("Expected 2 but got %v (%v)", value, err)
+	}
+}
+
+func TestListToSlice(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	var list = [1, "two", true, null, [3, [4]], {"five": 5}]
+	var empty = []
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	value, _ := vm.GetVariable("main", "list")
+	list := value.(*ListHandle)
+	defer list.Free()
+	slice, err := list.ToSlice(false)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if len(slice) != 6 || slice[0] != 1.0 || slice[1] != "two" || slice[2] != true || slice[3] != nil {
+		t.Errorf("Unexpected slice %v", slice)
+	}
+	if _, ok := slice[4].(*ListHandle); !ok {
+		t.Errorf("Expected a nested list handle but got %v", slice[4])
+	}
+	vm.FreeAll(slice...)
+	slice, err = list.ToSlice(true)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if nested := []interface{}{3.0, []interface{}{4.0}}; !reflect.DeepEqual(slice[4], nested) {
+		t.Errorf("Expected %v but got %v", nested, slice[4])
+	}
+	vm.FreeAll(slice...)
+	value, _ = vm.GetVariable("main", "empty")
+	empty := value.(*ListHandle)
+	defer empty.Free()
+	if slice, err := empty.ToSlice(true); err != nil || len(slice) != 0 {
+		t.Errorf("Expected an empty slice but got %v (%v)", slice, err)
+	}
+}
+
+func TestMapToMap(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	var map = {"a": 1, 2: [3, {"b": false}], true: null}
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	value, _ := vm.GetVariable("main", "map")
+	m := value.(*MapHandle)
+	defer m.Free()
+	keys, err := m.Keys()
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	if len(keys) != 3 {
+		t.Errorf("Expected 3 keys but got %v", keys)
+	}
+	visited := 0
+	err = m.ForEach(func(key, value interface{}) error {
+		visited++
+		if key == "a" && value != 1.0 {
+			t.Errorf("Expected 1 but got %v", value)
+		}
+		return nil
+	})
+	if err != nil || visited != 3 {
+		t.Errorf("Expected to visit 3 entries but visited %v (%v)", visited, err)
+	}
+	contents, err := m.ToMap(true)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	expected := map[interface{}]interface{}{
+		"a":  1.0,
+		2.0:  []interface{}{3.0, map[interface{}]interface{}{"b": false}},
+		true: nil,
+	}
+	if !reflect.DeepEqual(contents, expected) {
+		t.Errorf("Expected %v but got %v", expected, contents)
+	}
+}
+
+func TestConvertGoCollections(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	class Describe {
+		static call(list, map) { "%(list.count) %(list[1][0]) %(map["b"])" }
+	}`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	result, err := vm.CallStatic("main", "Describe", "call(_,_)", []interface{}{"a", [2]int{1, 2}}, map[string]int{"b": 3})
+	if err != nil {
+		t.Error(err.Error())
+	} else if result != "2 1 3" {
+		t.Errorf("Expected \"2 1 3\" but got %q", result)
+	}
+	if _, err := vm.CallStatic("main", "Describe", "call(_,_)", []interface{}{struct{}{}}, nil); err == nil {
+		t.Error("Expected an error converting a slice with an invalid element")
+	}
+
+	cfg := createConfig(t)
+	cfg.StrictValues = true
+	strict := cfg.NewVM()
+	defer strict.Free()
+	list, _ := strict.NewList()
+	defer list.Free()
+	if err := list.Insert([]string{"a"}); err == nil {
+		t.Error("Expected an error inserting a slice with StrictValues")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	type server struct {
+		Host    string `wren:"host"`
+		Port    uint16 `wren:"port"`
+		Tags    []string
+		Secret  string `wren:"-"`
+		Comment string `wren:",omitempty"`
+	}
+	type config struct {
+		Name    string
+		Servers []server
+		Limits  map[string]int
+		Extra   interface{}
+		Backup  *server
+	}
+	in := config{
+		Name:    "prod",
+		Servers: []server{{Host: "a", Port: 80, Tags: []string{"web"}, Secret: "hidden"}},
+		Limits:  map[string]int{"cpu": 2},
+		Extra:   []interface{}{1.0, "x"},
+	}
+	value, err := vm.Marshal(in)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	defer vm.FreeAll(value)
+	err = vm.InterpretString("main", `
+	class Check {
+		static call(config) {
+			var server = config["Servers"][0]
+			return "%(config["Name"]) %(server["host"]):%(server["port"]) %(server.containsKey("Secret")) %(server.containsKey("Comment")) %(config["Backup"])"
+		}
+	}`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	result, err := vm.CallStatic("main", "Check", "call(_)", value)
+	if err != nil {
+		t.Error(err.Error())
+	} else if result != "prod a:80 false false null" {
+		t.Errorf("Unexpected result %q", result)
+	}
+	var out config
+	if err := vm.Unmarshal(value, &out); err != nil {
+		t.Error(err.Error())
+		return
+	}
+	in.Servers[0].Secret = ""
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("Expected %+v but got %+v", in, out)
+	}
+	var port struct {
+		Port int8 `wren:"port"`
+	}
+	err = vm.Unmarshal(map[interface{}]interface{}{"port": 1000.0}, &port)
+	if typeErr, ok := err.(*UnmarshalTypeError); !ok || typeErr.Field != "port" {
+		t.Errorf("Expected an UnmarshalTypeError for port but got %v", err)
+	}
+}
+
+type boundPoint struct {
+	X, Y  float64
+	Label string
+	hits  int
+}
+
+func (p *boundPoint) MoveBy(dx, dy float64) {
+	p.X += dx
+	p.Y += dy
+}
+
+func (p boundPoint) Sum() float64 {
+	return p.X + p.Y
+}
+
+func (p *boundPoint) Scale(factor int) (*boundPoint, error) {
+	if factor == 0 {
+		return nil, errors.New("cannot scale by zero")
+	}
+	return &boundPoint{X: p.X * float64(factor), Y: p.Y * float64(factor)}, nil
+}
+
+func TestBindStruct(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	class, err := BindStruct(boundPoint{})
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	for _, signature := range []string{"x", "x=(_)", "label", "moveBy(_,_)", "sum()", "scale(_)"} {
+		if _, ok := class.MethodMap[signature]; !ok {
+			t.Errorf("Expected BindStruct to bind %q", signature)
+		}
+	}
+	vm.SetModule("main", NewModule(ClassMap{"Point": class}))
+	err = vm.InterpretString("main", `
+	foreign class Point {
+		construct new(x, y) {}
+		foreign x
+		foreign y
+		foreign label=(value)
+		foreign label
+		foreign moveBy(dx, dy)
+		foreign sum()
+		foreign scale(factor)
+	}
+	var p = Point.new(1, 2)
+	p.moveBy(2, 3)
+	p.label = "p"
+	var result = "%(p.label) %(p.x) %(p.y) %(p.sum()) %(p.scale(2)["X"])"
+	var failed = Fiber.new { p.scale(0) }.try()
+	var wrongType = Fiber.new { p.moveBy("a", 1) }.try()
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	for name, expected := range map[string]string{
+		"result":    "p 3 5 8 6",
+		"failed":    "cannot scale by zero",
+		"wrongType": "Cannot unmarshal Wren string into field \"MoveBy argument 1\" of Go type float64",
+	} {
+		if value, _ := vm.GetVariable("main", name); value != expected {
+			t.Errorf("Expected %v to be %q but got %q", name, expected, value)
+		}
+	}
+	if _, err := BindStruct(3); err == nil {
+		t.Error("Expected an error binding a number")
+	}
+	if bound, err := Bind[*boundPoint](); err != nil || len(bound.MethodMap) != len(class.MethodMap) {
+		t.Errorf("Expected Bind to bind the same methods but got %v (%v)", bound, err)
+	}
+	if _, err := Bind[int](); err == nil {
+		t.Error("Expected an error binding an int")
+	}
+	if wrenName("URLPath") != "urlPath" || wrenName("ID") != "id" {
+		t.Errorf("Unexpected names %v %v", wrenName("URLPath"), wrenName("ID"))
+	}
+}
+
+func TestTypedMethods(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Typed": NewClass(nil, nil, MethodMap{
+			"static repeat(_,_)": Method2(func(vm *VM, text string, times int) (interface{}, error) {
+				return strings.Repeat(text, times), nil
+			}),
+			"static sum(_)": Method1(func(vm *VM, numbers []float64) (interface{}, error) {
+				total := 0.0
+				for _, n := range numbers {
+					total += n
+				}
+				return total, nil
+			}),
+			"static kind(_)": Method1(func(vm *VM, value interface{}) (interface{}, error) {
+				_, isList := value.(*ListHandle)
+				return isList, nil
+			}),
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Typed {
+		foreign static repeat(text, times)
+		foreign static sum(numbers)
+		foreign static kind(value)
+	}
+	var repeated = Typed.repeat("ab", 3)
+	var sum = Typed.sum([1, 2, 3.5])
+	var isList = Typed.kind([])
+	var badType = Fiber.new { Typed.repeat("ab", 1.5) }.try()
+	`)
+	if err != nil {
+		t.Error(err.Error())
+		return
+	}
+	for name, expected := range map[string]interface{}{
+		"repeated": "ababab",
+		"sum":      6.5,
+		"isList":   true,
+		"badType":  "Cannot unmarshal Wren number into field \"argument 2\" of Go type int",
+	} {
+		if value, _ := vm.GetVariable("main", name); value != expected {
+			t.Errorf("Expected %v to be %v but got %v", name, expected, value)
+		}
+	}
+	fn := Method1(func(vm *VM, a string) (interface{}, error) { return nil, nil })
+	if _, err := fn(vm, []interface{}{nil}); err == nil {
+		t.Error("Expected an ArityMismatch")
+	}
+}
+
+func TestInterpretContext(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static tick()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				calls++
+				if calls == 3 {
+					cancel()
+				}
+				return nil, nil
+			},
+		}),
+	}))
+	err := vm.InterpretStringContext(ctx, "main", `
+	class Host {
+		foreign static tick()
+	}
+	var caught = Fiber.new {
+		while (true) Host.tick()
+	}.try()
+	// the interrupt keeps aborting foreign calls after it is caught
+	while (true) Host.tick()
+	`)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected the script to be canceled but got %v", err)
+	}
+	if err := vm.InterpretStringContext(context.Background(), "other", `System.print("still usable")`); err != nil {
+		t.Errorf("Expected the VM to be usable after an interrupt but got %v", err)
+	}
+	// loops that never call into Go are stopped too
+	deadline, cancelDeadline := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelDeadline()
+	if err := vm.InterpretStringContext(deadline, "spin", `while (true) {}`); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected a loop without foreign calls to be interrupted but got %v", err)
+	}
+	value, _ := vm.GetVariable("main", "Host")
+	host := value.(*Handle)
+	defer host.Free()
+	fn, _ := host.Func("tick()")
+	defer fn.Free()
+	expired, cancelExpired := context.WithTimeout(context.Background(), 0)
+	defer cancelExpired()
+	if _, err := fn.CallContext(expired); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected an expired deadline but got %v", err)
+	}
+}
+
+func TestFuel(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.Fuel = 100
+	vm := cfg.NewVM()
+	defer vm.Free()
+	calls := 0
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static work()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				calls++
+				return nil, nil
+			},
+		}),
+	}))
+	// loops that never call into Go use fuel too
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static work()
+	}
+	while (true) {}
+	`)
+	var budget *BudgetExceeded
+	if !errors.As(err, &budget) || budget.Limit != 100 || budget.Used != 101 {
+		t.Errorf("Expected BudgetExceeded but got %v", err)
+	}
+	// fuel is given again for every interpretation
+	if err := vm.InterpretString("main", `for (i in 1..5) Host.work()`); err != nil {
+		t.Errorf("Expected enough fuel but got %v", err)
+	}
+	if calls != 5 {
+		t.Errorf("Expected 5 foreign calls but got %v", calls)
+	}
+	if err := vm.InterpretString("main", `while (true) Host.work()`); !errors.As(err, &budget) {
+		t.Errorf("Expected BudgetExceeded but got %v", err)
+	}
+
+	cfg = createConfig(t)
+	cfg.FuelFn = func(vm *VM, used int64) bool {
+		return used <= 3
+	}
+	vm = cfg.NewVM()
+	defer vm.Free()
+	err = vm.InterpretString("main", `
+	var Fib = Fn.new {|n| n < 2 ? n : Fib.call(n - 1) + Fib.call(n - 2) }
+	Fib.call(30)
+	`)
+	if !errors.As(err, &budget) || budget.Used != 4 {
+		t.Errorf("Expected BudgetExceeded from FuelFn but got %v", err)
+	}
+}
+
+func TestMemoryLimit(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.MaxHeapBytes = 1 << 20
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static tick()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static tick()
+	}
+	Fn.new {
+		var list = []
+		// the script never calls into Go, so it has to be stopped by the allocator
+		for (i in 1..1e9) list.add(List.filled(128, i))
+	}.call()
+	`)
+	var oom *OutOfMemory
+	if !errors.As(err, &oom) || oom.Limit != 1<<20 {
+		t.Errorf("Expected OutOfMemory but got %v", err)
+	}
+	if vm.HeapUsed() <= 0 || vm.HeapPeak() < vm.HeapUsed() {
+		t.Errorf("Expected heap usage to be tracked but got %v used and %v peak", vm.HeapUsed(), vm.HeapPeak())
+	}
+	// garbage alone shouldn't go over the limit
+	if err := vm.InterpretString("main", `for (i in 1..10000) {
+		var garbage = List.filled(128, i)
+		Host.tick()
+	}`); err != nil {
+		t.Errorf("Expected garbage to be collected but got %v", err)
+	}
+}
+
+func TestReallocateFn(t *testing.T) {
+	cfg := createConfig(t)
+	var allocated, freed, used int
+	cfg.ReallocateFn = func(vm *VM, oldSize, newSize int) {
+		if oldSize == 0 {
+			allocated++
+		}
+		if newSize == 0 {
+			freed++
+		}
+		used += newSize - oldSize
+	}
+	vm := cfg.NewVM()
+	if err := vm.InterpretString("main", `var list = List.filled(1000, 0)`); err != nil {
+		t.Error(err)
+	}
+	if allocated == 0 || used <= 0 || int64(used) != vm.HeapUsed() {
+		t.Errorf("Expected allocations to be reported but got %v allocations and %v bytes used (VM reports %v)", allocated, used, vm.HeapUsed())
+	}
+	vm.Free()
+	if used != 0 || freed == 0 {
+		t.Errorf("Expected every allocation to be freed but %v bytes are still used", used)
+	}
+}
+
+func TestResolveRelative(t *testing.T) {
+	cfg := createConfig(t)
+	var loaded []string
+	cfg.LoadModuleFn = func(vm *VM, name string) (source string, ok bool) {
+		loaded = append(loaded, name)
+		switch name {
+		case "lib/app":
+			return `import "./util"
+			import "../shared/log"`, true
+		case "lib/util", "shared/log":
+			return "", true
+		}
+		return "", false
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `import "./lib/app"`); err != nil {
+		t.Error(err)
+	}
+	if !reflect.DeepEqual(loaded, []string{"lib/app", "lib/util", "shared/log"}) {
+		t.Errorf("Expected imports to resolve relative to their importer but loaded %v", loaded)
+	}
+	for _, name := range []string{"../secret", "./lib/../../secret"} {
+		if resolved, ok := ResolveRelative(nil, "main", name); ok {
+			t.Errorf("Expected %q not to resolve above the top directory but got %q", name, resolved)
+		}
+	}
+}
+
+func TestFSModuleLoader(t *testing.T) {
+	fsys := fstest.MapFS{
+		"scripts/app.wren":       {Data: []byte(`import "./lib/greet" for Greet` + "\n" + `var message = Greet.hello("fs")`)},
+		"scripts/lib/greet.wren": {Data: []byte("#!/usr/bin/env wrengo\nclass Greet {\n static hello(name) { \"Hello \" + name }\n}")},
+		"secret.wren":            {Data: []byte(`System.print("should not load")`)},
+	}
+	cfg := createConfig(t)
+	cfg.LoadModuleFn = FSModuleLoader(fsys, "scripts")
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `import "app" for message`); err != nil {
+		t.Error(err)
+	}
+	if message, _ := vm.GetVariable("main", "message"); message != "Hello fs" {
+		t.Errorf("Expected \"Hello fs\" but got %v", message)
+	}
+	if err := vm.InterpretString("escape", `import "../secret"`); err == nil {
+		t.Error("Expected modules outside of root to not load")
+	}
+}
+
+func TestSearchPathLoader(t *testing.T) {
+	first, second := t.TempDir(), t.TempDir()
+	writeFiles(t, first, map[string]string{
+		"util.wren": `var from = "first"`,
+	})
+	writeFiles(t, second, map[string]string{
+		"util.wren":              `var from = "second"`,
+		"json/module.wren":       `var kind = "package"`,
+		"json/encode/value.wren": `var kind = "file"`,
+	})
+	cfg := createConfig(t)
+	cfg.LoadModuleFn = SearchPathLoader(first, second)
+	vm := cfg.NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	import "util" for from
+	import "json" for kind
+	import "json/encode/value.wren" for kind as valueKind
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, expected := range map[string]string{"from": "first", "kind": "package", "valueKind": "file"} {
+		if value, _ := vm.GetVariable("main", name); value != expected {
+			t.Errorf("Expected %v to be %v but got %v", name, expected, value)
+		}
+	}
+	// both directories are in the same temporary directory
+	if _, ok := SearchPathLoader(first)(nil, "../"+filepath.Base(second)+"/util"); ok {
+		t.Error("Expected a module outside of the search path not to load")
+	}
+	if _, ok := DefaultModuleLoader(nil, "../wren.go"); ok {
+		t.Error("Expected the default loader not to load files outside of the current directory")
+	}
+	if _, ok := DefaultModuleLoader(nil, "wren.go"); !ok {
+		t.Error("Expected the default loader to load files in the current directory")
+	}
+}
+
+func TestModuleProvider(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.ModuleProviderFn = func(vm *VM, name string) (*Module, bool) {
+		if name != "plugin" {
+			return nil, false
+		}
+		module := NewModule(ClassMap{
+			"Plugin": NewClass(nil, nil, MethodMap{
+				"static name()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+					return "provided", nil
+				},
+			}),
+		})
+		module.Source = `
+		class Plugin {
+			foreign static name()
+		}`
+		return module, true
+	}
+	cfg.LoadModuleFn = func(vm *VM, name string) (string, bool) {
+		return `var fallback = true`, name == "other"
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	import "plugin" for Plugin
+	import "other" for fallback
+	var name = Plugin.name()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name, _ := vm.GetVariable("main", "name"); name != "provided" {
+		t.Errorf("Expected the provided module's foreign method to be bound but got %v", name)
+	}
+	if fallback, _ := vm.GetVariable("main", "fallback"); fallback != true {
+		t.Error("Expected LoadModuleFn to be used for modules that aren't provided")
+	}
+}
+
+func TestFiberHandle(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	var counter = Fiber.new {|start|
+		var n = start
+		while (n < start + 2) {
+			n = Fiber.yield(n) + n
+		}
+		return "done"
+	}
+	var failing = Fiber.new { Fiber.abort("failed") }
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, _ := vm.GetVariable("main", "counter")
+	counter, ok := value.(*FiberHandle)
+	if !ok {
+		t.Fatalf("Expected a FiberHandle but got %T", value)
+	}
+	defer counter.Free()
+	if value, err := counter.Call(10); value != 10.0 || err != nil {
+		t.Errorf("Expected the fiber to yield 10 but got %v, %v", value, err)
+	}
+	if done, _ := counter.IsDone(); done {
+		t.Error("Expected the fiber to be paused")
+	}
+	if value, err := counter.Call(5); value != "done" || err != nil {
+		t.Errorf("Expected the fiber to return \"done\" but got %v, %v", value, err)
+	}
+	if done, _ := counter.IsDone(); !done {
+		t.Error("Expected the fiber to be done")
+	}
+	if _, err := counter.Call(1, 2); err == nil {
+		t.Error("Expected an error sending two values to a fiber")
+	}
+
+	value, _ = vm.GetVariable("main", "failing")
+	failing := value.(*FiberHandle)
+	defer failing.Free()
+	if value, err := failing.Try(); value != "failed" || err != nil {
+		t.Errorf("Expected Try to return the abort message but got %v, %v", value, err)
+	}
+	if message, _ := failing.Error(); message != "failed" {
+		t.Errorf("Expected the fiber's error to be \"failed\" but got %v", message)
+	}
+}
+
+func TestClassHandle(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	class Point {
+		construct new(x, y) {
+			_x = x
+			_y = y
+		}
+		construct new() {
+			_x = 0
+			_y = 0
+		}
+		sum { _x + _y }
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, _ := vm.GetVariable("main", "Point")
+	if _, ok := value.(*Handle); !ok {
+		t.Errorf("Expected GetVariable to return classes as a Handle but got %T", value)
+	}
+	vm.FreeAll(value)
+	class, err := vm.GetClass("main", "Point")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer class.Free()
+	if name, err := class.Name(); name != "Point" || err != nil {
+		t.Errorf("Expected the class to be named Point but got %v, %v", name, err)
+	}
+	for _, test := range []struct {
+		args []interface{}
+		sum  float64
+	}{{[]interface{}{3, 4}, 7}, {nil, 0}} {
+		instance, err := class.New(test.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fn, _ := instance.(*Handle).Func("sum")
+		sum, _ := fn.Call()
+		fn.Free()
+		vm.FreeAll(instance)
+		if sum != test.sum {
+			t.Errorf("Expected new(%v) to have a sum of %v but got %v", test.args, test.sum, sum)
+		}
+	}
+	if _, err := class.New(1); err == nil {
+		t.Error("Expected an error calling a constructor that doesn't exist")
+	}
+	if _, err := vm.GetClass("main", "Missing"); err == nil {
+		t.Error("Expected an error getting a missing class")
+	}
+}
+
+func TestFnHandle(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var callback *FnHandle
+	vm.SetModule("main", NewModule(ClassMap{
+		"Events": NewClass(nil, nil, MethodMap{
+			"static on(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				fn, ok := parameters[1].(*FnHandle)
+				if !ok {
+					return nil, fmt.Errorf("expected a function but got %T", parameters[1])
+				}
+				var err error
+				callback, err = fn.Copy()
+				return nil, err
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	foreign class Events {
+		foreign static on(fn)
+	}
+	Events.on {|a, b| a + b }
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if callback == nil {
+		t.Fatal("Expected the callback to be passed as an FnHandle")
+	}
+	defer callback.Free()
+	if sum, err := callback.Call(2, 3); sum != 5.0 || err != nil {
+		t.Errorf("Expected the callback to return 5 but got %v, %v", sum, err)
+	}
+	if _, err := callback.Call(1); err == nil {
+		t.Error("Expected an error calling the callback with too few parameters")
+	}
+}
+
+func TestNewFn(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static adder(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				n := parameters[1].(float64)
+				return vm.NewFn(func(vm *VM, args []interface{}) (interface{}, error) {
+					return n + args[0].(float64), nil
+				})
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	import "wrengo/fn"
+	foreign class Host {
+		foreign static adder(n)
+	}
+	class Runner {
+		static run(fn) { fn.call(2, 3) }
+	}
+	var addTen = Host.adder(10)
+	var result = addTen.call(5)
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, _ := vm.GetVariable("main", "result"); result != 15.0 {
+		t.Errorf("Expected a Go function returned to Wren to give 15 but got %v", result)
+	}
+	var got []interface{}
+	fn, err := vm.NewFn(func(vm *VM, args []interface{}) (interface{}, error) {
+		got = args
+		return "called", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fn.Free()
+	result, err := vm.CallStatic("main", "Runner", "run(_)", fn)
+	if result != "called" || err != nil || !reflect.DeepEqual(got, []interface{}{2.0, 3.0}) {
+		t.Errorf("Expected Wren to call the Go function with [2 3] but got %v, %v, %v", got, result, err)
+	}
+
+	unimported := createConfig(t).NewVM()
+	defer unimported.Free()
+	var newErr error
+	unimported.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static make()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				_, newErr = vm.NewFn(func(vm *VM, args []interface{}) (interface{}, error) { return nil, nil })
+				return nil, nil
+			},
+		}),
+	}))
+	unimported.InterpretString("main", `
+	foreign class Host {
+		foreign static make()
+	}
+	Host.make()
+	`)
+	if _, ok := newErr.(*ModuleNotImported); !ok {
+		t.Errorf("Expected ModuleNotImported creating a function while running without importing \"wrengo/fn\" but got %v", newErr)
+	}
+}
+
+func TestVMCall(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	class Calc {
+		static add(a, b) { a + b }
+	}
+	class Counter {
+		construct new() { _count = 0 }
+		increment(n) { _count = _count + n }
+	}
+	var counter = Counter.new()
+	var notAnObject = 3
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handles := len(vm.handles)
+	if value, err := vm.Call("main", "Calc", "static add(_,_)", 1, 2); value != 3.0 || err != nil {
+		t.Errorf("Expected 3 but got %v, %v", value, err)
+	}
+	for i := 1; i <= 2; i++ {
+		if value, err := vm.Call("main", "counter", "increment(_)", 5); value != float64(i*5) || err != nil {
+			t.Errorf("Expected %v but got %v, %v", i*5, value, err)
+		}
+	}
+	if len(vm.handles) != handles {
+		t.Errorf("Expected Call to free its handles but there are %v more", len(vm.handles)-handles)
+	}
+	if _, err := vm.Call("main", "notAnObject", "increment(_)", 1); err == nil {
+		t.Error("Expected an error calling a method on a number")
+	}
+}
+
+func TestTypedResults(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	class Calc {
+		static add(a, b) { a + b }
+	}
+	var items = [1, 2, 3]
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := VarAs[*ListHandle](vm, "main", "items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer items.Free()
+	if count, _ := items.Count(); count != 3 {
+		t.Errorf("Expected 3 items but got %v", count)
+	}
+	class, err := VarAs[*Handle](vm, "main", "Calc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer class.Free()
+	add, _ := class.Func("add(_,_)")
+	defer add.Free()
+	if sum, err := CallAs[float64](add, 1, 2); sum != 3 || err != nil {
+		t.Errorf("Expected 3 but got %v, %v", sum, err)
+	}
+	var mismatch *TypeMismatch
+	if _, err := CallAs[string](add, 1, 2); !errors.As(err, &mismatch) || mismatch.Got != reflect.TypeOf(0.0) {
+		t.Errorf("Expected a TypeMismatch but got %v", err)
+	}
+	if _, err := VarAs[float64](vm, "main", "items"); !errors.As(err, &mismatch) {
+		t.Errorf("Expected a TypeMismatch but got %v", err)
+	}
+}
+
+func TestCompileDiagnostics(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.LoadModuleFn = func(vm *VM, name string) (string, bool) {
+		return "var broken = (", name == "broken"
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", "var a = 1\nvar b = )\n")
+	var compileErr *ResultCompileError
+	if !errors.As(err, &compileErr) || len(compileErr.Diagnostics) == 0 {
+		t.Fatalf("Expected compile diagnostics but got %v", err)
+	}
+	if diagnostic := compileErr.Diagnostics[0]; diagnostic.Module() != "main" || diagnostic.Line() != 2 || diagnostic.Message() == "" {
+		t.Errorf("Expected a diagnostic on main line 2 but got %v", diagnostic)
+	}
+	// a module that fails to compile when imported aborts the importer at runtime
+	if err := vm.InterpretString("other", `import "broken"`); err == nil {
+		t.Error("Expected importing a broken module to fail")
+	}
+	if err := vm.InterpretString("fine", "var c = 3"); err != nil {
+		t.Errorf("Expected diagnostics to not carry over to the next run but got %v", err)
+	}
+}
+
+func TestRuntimeTrace(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	class Thrower {
+		static inner() { Fiber.abort("broken") }
+		static outer() { inner() }
+	}
+	Thrower.outer()
+	`)
+	var traced *RuntimeErrorWithTrace
+	if !errors.As(err, &traced) {
+		t.Fatalf("Expected a runtime error with a trace but got %v", err)
+	}
+	if !errors.As(err, new(*ResultRuntimeError)) {
+		t.Error("Expected the trace to unwrap to ResultRuntimeError")
+	}
+	expected := []StackFrame{
+		{Module: "main", Line: 3, Function: "inner()"},
+		{Module: "main", Line: 4, Function: "outer()"},
+		{Module: "main", Line: 6, Function: "(script)"},
+	}
+	if traced.Message != "broken" || !reflect.DeepEqual(traced.Trace, expected) {
+		t.Errorf("Expected message \"broken\" with trace %v but got %q with %v", expected, traced.Message, traced.Trace)
+	}
+}
+
+func TestAbortValue(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `Fiber.abort(["broken", 42])`)
+	var traced *RuntimeErrorWithTrace
+	if !errors.As(err, &traced) {
+		t.Fatalf("Expected a runtime error with a trace but got %v", err)
+	}
+	if expected := []interface{}{"broken", 42.0}; !reflect.DeepEqual(traced.Value, expected) {
+		t.Errorf("Expected the abort value to be %v but got %v", expected, traced.Value)
+	}
+	before := len(vm.handles)
+	err = vm.InterpretString("main", `
+	class Problem {
+		construct new() {}
+	}
+	Fiber.abort(Problem.new())`)
+	if !errors.As(err, &traced) {
+		t.Fatalf("Expected a runtime error with a trace but got %v", err)
+	}
+	if _, ok := traced.Value.(*Handle); !ok || len(vm.handles) != before {
+		t.Errorf("Expected an instance in a handle that is released once collected but got %v", traced.Value)
+	}
+	err = vm.InterpretString("main", `Fiber.abort(7)`)
+	if !errors.As(err, &traced) || traced.Value != 7.0 {
+		t.Errorf("Expected the abort value to be 7 but got %v", err)
+	}
+	err = vm.InterpretString("main", `Fiber.abort("broken")`)
+	if !errors.As(err, &traced) || traced.Value != "broken" {
+		t.Errorf("Expected the abort value to be \"broken\" but got %v", err)
+	}
+}
+
+func TestForeignAbortValue(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Thrower": NewClass(nil, nil, MethodMap{
+			"static fail()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				vm.AbortValue(map[string]interface{}{"code": 404, "reason": "not found"})
+				return nil, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Thrower {
+		foreign static fail()
+	}
+	var error = Fiber.new { Thrower.fail() }.try()
+	var code = error["code"]
+	var reason = error["reason"]
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code, _ := vm.GetVariable("main", "code"); code != 404.0 {
+		t.Errorf("Expected the caught code to be 404 but got %v", code)
+	}
+	if reason, _ := vm.GetVariable("main", "reason"); reason != "not found" {
+		t.Errorf("Expected the caught reason to be \"not found\" but got %v", reason)
+	}
+}
+
+func TestForeignPanic(t *testing.T) {
+	cfg := createConfig(t)
+	var handled *ForeignPanic
+	cfg.PanicHandler = func(vm *VM, err *ForeignPanic) {
+		handled = err
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Crasher": NewClass(nil, nil, MethodMap{
+			"static crash()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				panic("something went wrong")
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Crasher {
+		foreign static crash()
+	}
+	var error = Fiber.new { Crasher.crash() }.try()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if handled == nil || handled.Value != "something went wrong" {
+		t.Fatalf("Expected the panic to be handled but got %v", handled)
+	}
+	if message, _ := vm.GetVariable("main", "error"); message != handled.Error() {
+		t.Errorf("Expected the fiber to abort with %q but got %v", handled.Error(), message)
+	}
+}
+
+func TestAutoFreeHandles(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.AutoFreeHandles = true
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `var items = [1, 2, 3]`); err != nil {
+		t.Fatal(err)
+	}
+	var collected []*Handle
+	for i := 0; i < 10; i++ {
+		items, err := VarAs[*ListHandle](vm, "main", "items")
+		if err != nil {
+			t.Fatal(err)
+		}
+		collected = append(collected, items.handle)
+	}
+	if len(vm.handles) != 0 || len(vm.released.live) != 10 {
+		t.Fatalf("Expected 10 tracked handles but got %v", len(vm.released.live))
+	}
+	// when and whether Go collects them is up to Go, so their finalizer is called the same way it would be
+	for _, h := range collected {
+		vm.released.collected(h)
+	}
+	// creating a handle releases the ones that were collected
+	items, err := VarAs[*ListHandle](vm, "main", "items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vm.released.live) != 1 {
+		t.Errorf("Expected collected handles to be released but %v are still live", len(vm.released.live))
+	}
+	handle := items.handle.handle
+	items.Free()
+	if _, ok := vm.released.live[handle]; ok {
+		t.Error("Expected a freed handle to no longer be tracked")
+	}
+}
+
+func TestSyncVM(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	var shared *SyncVM
+	vm.SetModule("main", NewModule(ClassMap{
+		"Counter": NewClass(nil, nil, MethodMap{
+			"static reenter()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				_, err := shared.GetVariable("main", "count")
+				return errors.As(err, new(*ReentrantCallError)), nil
+			},
+		}),
+	}))
+	shared = NewSyncVM(vm)
+	defer shared.Free()
+	err := shared.InterpretString("main", `
+	class Counter {
+		foreign static reenter()
+		static count { __count }
+		static increment() { __count = (__count || 0) + 1 }
+	}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	for i := 0; i < 8; i++ {
+		go func() {
+			for j := 0; j < 25; j++ {
+				if _, err := shared.CallStatic("main", "Counter", "increment()"); err != nil {
+					done <- err
+					return
+				}
+			}
+			done <- nil
+		}()
+	}
+	for i := 0; i < 8; i++ {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if count, err := shared.Call("main", "Counter", "count"); err != nil || count != 200.0 {
+		t.Errorf("Expected count to be 200 but got %v (%v)", count, err)
+	}
+	if ok, err := shared.Call("main", "Counter", "reenter()"); err != nil || ok != true {
+		t.Errorf("Expected a reentrant call to return ReentrantCallError but got %v (%v)", ok, err)
+	}
+}
+
+func TestSyncVMPinned(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.PinToThread = true
+	vm := cfg.NewVM()
+	var shared *SyncVM
+	vm.SetModule("main", NewModule(ClassMap{
+		"Counter": NewClass(nil, nil, MethodMap{
+			"static reenter()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				_, err := shared.GetVariable("main", "Counter")
+				return errors.As(err, new(*ReentrantCallError)), nil
+			},
+		}),
+	}))
+	shared = NewSyncVM(vm)
+	if err := shared.InterpretString("main", "class Counter {\n foreign static reenter()\n}"); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	var ok interface{}
+	var err error
+	go func() {
+		ok, err = shared.Call("main", "Counter", "reenter()")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		// the VM is still locked so it can't be freed
+		t.Fatal("Expected a reentrant call on the pinned thread to return instead of deadlocking")
+	}
+	shared.Free()
+	if err != nil || ok != true {
+		t.Errorf("Expected a reentrant call to return ReentrantCallError but got %v (%v)", ok, err)
+	}
+}
+
+func TestPool(t *testing.T) {
+	pool, err := NewPool(createConfig(t), 4, func(vm *VM) error {
+		return vm.InterpretString("main", `
+		class Math {
+			static square(n) { n * n }
+			static range(n) { (0...n).toList }
+		}
+		`)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Free()
+	ctx := context.Background()
+	done := make(chan error)
+	for i := 0; i < 16; i++ {
+		go func(i int) {
+			value, err := pool.Call(ctx, "main", "Math", "square(_)", i)
+			if err == nil && value != float64(i*i) {
+				err = fmt.Errorf("Expected %v squared to be %v but got %v", i, i*i, value)
+			}
+			done <- err
+		}(i)
+	}
+	for i := 0; i < 16; i++ {
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}
+	value, err := pool.Call(ctx, "main", "Math", "range(_)", 3)
+	if err != nil || !reflect.DeepEqual(value, []interface{}{0.0, 1.0, 2.0}) {
+		t.Errorf("Expected the list to be copied into a slice but got %v (%v)", value, err)
+	}
+	if _, err := pool.Call(ctx, "main", "Math", "toString"); err != nil {
+		t.Error(err)
+	}
+	if _, err := pool.Call(ctx, "main", "Math", "type"); !errors.As(err, new(*UnexpectedValue)) {
+		t.Errorf("Expected a class result to return UnexpectedValue but got %v", err)
+	}
+	pool.Free()
+	if err := pool.InterpretString(ctx, "main", ""); !errors.As(err, new(*PoolClosed)) {
+		t.Errorf("Expected a freed pool to return PoolClosed but got %v", err)
+	}
+
+	single, err := NewPool(createConfig(t), 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer single.Free()
+	if err := single.InterpretString(ctx, "main", `var leftover = 1`); err != nil {
+		t.Fatal(err)
+	}
+	single.Do(ctx, func(vm *VM) error {
+		if vm.HasModule("main") {
+			t.Error("Expected the VM to be reset before it is used again")
+		}
+		return nil
+	})
+}
+
+func TestReset(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.Preludes = []Prelude{{Module: "main", Source: `var greeting = "hello"`}}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Native": NewClass(nil, nil, MethodMap{
+			"static answer()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return 42, nil
+			},
+		}),
+	}))
+	source := `
+	class Native {
+		foreign static answer()
+	}
+	var answer = Native.answer()
+	`
+	bindings := len(vm.bindMap)
+	for i := 0; i < 3; i++ {
+		if err := vm.InterpretString("main", source); err != nil {
+			t.Fatal(err)
+		}
+		if answer, _ := vm.GetVariable("main", "answer"); answer != 42.0 {
+			t.Fatalf("Expected answer to be 42 but got %v", answer)
+		}
+		list, err := vm.NewList()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Reset(); err != nil {
+			t.Fatal(err)
+		}
+		if len(vm.handles) != 0 || list.handle.handle != nil {
+			t.Fatal("Expected reset to free every handle")
+		}
+		if vm.HasVariable("main", "answer") {
+			t.Fatal("Expected reset to remove variables that scripts declared")
+		}
+		if greeting, _ := vm.GetVariable("main", "greeting"); greeting != "hello" {
+			t.Fatalf("Expected preludes to be interpreted again but got %v", greeting)
+		}
+	}
+	if len(vm.bindMap) != bindings {
+		t.Errorf("Expected %v bindings after resetting but got %v", bindings, len(vm.bindMap))
+	}
+}
+
+func TestInterrupt(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	started := make(chan struct{})
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static start()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				close(started)
+				return nil, nil
+			},
+		}),
+	}))
+	go func() {
+		<-started
+		vm.Interrupt()
+	}()
+	// the loop never calls into Go after it starts
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static start()
+	}
+	Host.start()
+	while (true) {}
+	`)
+	if !errors.As(err, new(*Interrupted)) || !errors.As(err, new(*InterruptRequested)) {
+		t.Errorf("Expected the script to be interrupted but got %v", err)
+	}
+	// interrupting a VM that isn't running does nothing
+	vm.Interrupt()
+	if err := vm.InterpretString("other", `System.print("still usable")`); err != nil {
+		t.Errorf("Expected the VM to be usable after an interrupt but got %v", err)
+	}
+}
+
+func TestMaxExecutionTime(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.MaxExecutionTime = 20 * time.Millisecond
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static tick()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return nil, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static tick()
+	}
+	while (true) {}
+	`)
+	var timeout *TimeoutError
+	if !errors.As(err, &timeout) || timeout.Limit != cfg.MaxExecutionTime || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the script to time out but got %v", err)
+	}
+	if _, err := vm.Call("main", "Host", "tick()"); err != nil {
+		t.Errorf("Expected a quick call to finish but got %v", err)
+	}
+}
+
+func TestMethodObserver(t *testing.T) {
+	cfg := createConfig(t)
+	var observed []MethodCall
+	cfg.MethodObserver = func(vm *VM, call MethodCall) {
+		observed = append(observed, call)
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static sleep()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				time.Sleep(time.Millisecond)
+				return nil, nil
+			},
+			"static fail()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return nil, errors.New("failed")
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static sleep()
+		foreign static fail()
+	}
+	Host.sleep()
+	Fiber.new { Host.fail() }.try()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(observed) != 2 {
+		t.Fatalf("Expected 2 observed calls but got %v", observed)
+	}
+	if call := observed[0]; call.Module != "main" || call.Class != "Host" || call.Signature != "static sleep()" || call.Duration < time.Millisecond {
+		t.Errorf("Unexpected observed call %+v", call)
+	}
+	if observed[1].Err == nil {
+		t.Error("Expected the failed call to be observed with its error")
+	}
+	vm.Call("main", "Host", "sleep()")
+	vm.GC()
+	stats := vm.Stats()
+	if stats.Interprets != 1 || stats.Calls != 1 || stats.ForeignCalls != 3 || stats.Aborts != 1 || stats.GCs != 1 {
+		t.Errorf("Unexpected stats %+v", stats)
+	}
+}
+
+func TestTracingHooks(t *testing.T) {
+	cfg := createConfig(t)
+	var events []string
+	cfg.BeforeInterpret = func(vm *VM, module string) {
+		events = append(events, "interpret "+module)
+	}
+	cfg.AfterInterpret = func(vm *VM, module string, err error) {
+		events = append(events, fmt.Sprintf("interpreted %v %v", module, err != nil))
+	}
+	cfg.BeforeCall = func(vm *VM, signature string) {
+		events = append(events, "call "+signature)
+	}
+	cfg.AfterCall = func(vm *VM, signature string, err error) {
+		events = append(events, fmt.Sprintf("called %v %v", signature, err != nil))
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	events = nil
+	vm.InterpretString("main", `class Math { static add(a, b) { a + b } }`)
+	vm.InterpretString("broken", `}`)
+	vm.Call("main", "Math", "add(_,_)", 1, 2)
+	expected := []string{
+		"interpret main",
+		"interpreted main false",
+		"interpret broken",
+		"interpreted broken true",
+		"call add(_,_)",
+		"called add(_,_) false",
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Expected hooks to be called with %v but got %v", expected, events)
+	}
+}
+
+func TestLineWriter(t *testing.T) {
+	cfg := createConfig(t)
+	var lines []string
+	cfg.LineWriter = func(vm *VM, line string) {
+		lines = append(lines, line)
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	System.print("first")
+	System.write("sec")
+	System.write("ond\nthird\n")
+	System.print()
+	System.printAll([1, 2])
+	System.write("unfinished")
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"first", "second", "third", "", "12", "unfinished"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Expected lines %q but got %q", expected, lines)
+	}
+}
+
+func TestListRemove(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `var items = ["a", "b", "c", [1]]`); err != nil {
+		t.Fatal(err)
+	}
+	items, err := VarAs[*ListHandle](vm, "main", "items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer items.Free()
+	if removed, err := items.Remove(1); err != nil || removed != "b" {
+		t.Errorf("Expected to remove \"b\" but got %v (%v)", removed, err)
+	}
+	removed, err := items.Remove(2)
+	if list, ok := removed.(*ListHandle); !ok || err != nil {
+		t.Errorf("Expected to remove a list but got %v (%v)", removed, err)
+	} else {
+		list.Free()
+	}
+	if _, err := items.Remove(2); !errors.As(err, new(*OutOfBounds)) {
+		t.Errorf("Expected OutOfBounds but got %v", err)
+	}
+	if values, _ := items.ToSlice(false); !reflect.DeepEqual(values, []interface{}{"a", "c"}) {
+		t.Errorf("Expected [a c] but got %v", values)
+	}
+	if err := items.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	items.Insert("d")
+	if values, _ := items.ToSlice(false); !reflect.DeepEqual(values, []interface{}{"d"}) {
+		t.Errorf("Expected [d] after clearing but got %v", values)
+	}
+}
+
+func TestListNegativeIndex(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	list, err := vm.NewList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Free()
+	list.Insert("a")
+	list.Insert("c")
+	if err := list.InsertAt(-2, "b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := list.InsertAt(-1, "d"); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := list.Get(-1); err != nil || value != "d" {
+		t.Errorf("Expected the last element to be \"d\" but got %v (%v)", value, err)
+	}
+	if err := list.Set(-4, "A"); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := list.Remove(-2); err != nil || value != "c" {
+		t.Errorf("Expected to remove \"c\" but got %v (%v)", value, err)
+	}
+	if values, _ := list.ToSlice(false); !reflect.DeepEqual(values, []interface{}{"A", "b", "d"}) {
+		t.Errorf("Expected [A b d] but got %v", values)
+	}
+	for _, err := range []error{
+		func() error { _, err := list.Get(-4); return err }(),
+		list.Set(3, nil),
+		list.InsertAt(-5, nil),
+		list.InsertAt(4, nil),
+		func() error { _, err := list.Remove(-4); return err }(),
+	} {
+		if !errors.As(err, new(*OutOfBounds)) {
+			t.Errorf("Expected OutOfBounds but got %v", err)
+		}
+	}
+}
+
+func TestListAppendAndSetAll(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	list, err := vm.NewList()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Free()
+	if err := list.Append(1, "two", []int{3}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := list.SetAll(-2, []interface{}{"three", 4, 5}); err != nil {
+		t.Fatal(err)
+	}
+	if values, _ := list.ToSlice(true); !reflect.DeepEqual(values, []interface{}{1.0, "two", "three", 4.0, 5.0}) {
+		t.Errorf("Expected [1 two three 4 5] but got %v", values)
+	}
+	if err := list.SetAll(5, []interface{}{6}); err != nil {
+		t.Fatal(err)
+	}
+	if err := list.SetAll(7, []interface{}{8}); !errors.As(err, new(*OutOfBounds)) {
+		t.Errorf("Expected OutOfBounds but got %v", err)
+	}
+	if err := list.Append(7, make(chan int)); err == nil {
+		t.Error("Expected an error for a value that can't be passed to Wren")
+	}
+	if count, _ := list.Count(); count != 6 {
+		t.Errorf("Expected the list to be left as it was with 6 elements but it has %v", count)
+	}
+}
+
+func TestMapSetAllAndGetMany(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	m, err := vm.NewMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Free()
+	err = m.SetAll(map[interface{}]interface{}{
+		"name":     "wren",
+		1:          true,
+		nil:        []int{1, 2},
+		2.5:        make(chan int),
+		struct{}{}: 3,
+	})
+	var keyErrs KeyErrors
+	if !errors.As(err, &keyErrs) || len(keyErrs) != 2 || !errors.As(keyErrs[struct{}{}], new(*InvalidKey)) {
+		t.Fatalf("Expected 2 keys to fail but got %v", err)
+	}
+	if count, _ := m.Count(); count != 3 {
+		t.Errorf("Expected the map to have 3 keys but it has %v", count)
+	}
+	results, err := m.GetMany([]interface{}{"name", 1, "missing", []int{}, nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Value != "wren" || results[1].Value != true {
+		t.Errorf("Unexpected results %v", results)
+	}
+	if !errors.As(results[2].Err, new(*KeyNotExist)) || !errors.As(results[3].Err, new(*InvalidKey)) {
+		t.Errorf("Expected per key errors but got %v", results)
+	}
+	list, ok := results[4].Value.(*ListHandle)
+	if !ok {
+		t.Fatalf("Expected a list but got %v", results[4])
+	}
+	list.Free()
+}
+
+func TestMapGetOK(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	m, err := vm.NewMap()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Free()
+	m.Set("empty", nil)
+	if value, ok, err := m.GetOK("empty"); err != nil || !ok || value != nil {
+		t.Errorf("Expected a null value to be found but got %v, %v, %v", value, ok, err)
+	}
+	if value, ok, err := m.GetOK("missing"); err != nil || ok || value != nil {
+		t.Errorf("Expected a missing key to not be found but got %v, %v, %v", value, ok, err)
+	}
+	if _, err := m.Get("missing"); !errors.As(err, new(*KeyNotExist)) {
+		t.Errorf("Expected Get to return KeyNotExist but got %v", err)
+	}
+	if _, _, err := m.GetOK([]int{}); !errors.As(err, new(*InvalidKey)) {
+		t.Errorf("Expected InvalidKey but got %v", err)
+	}
+}
+
+func TestRangeHandle(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var received []int
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static receive(_)": Method1(func(vm *VM, numbers []int) (interface{}, error) {
+				received = numbers
+				return nil, nil
+			}),
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static receive(numbers)
+	}
+	Host.receive(1..3)
+	var up = 1...4
+	var down = 3..1
+	var empty = 2...2
+	var endless = 1..Num.infinity
+	var nan = 0..(0/0)
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(received, []int{1, 2, 3}) {
+		t.Errorf("Expected a range to be unmarshaled into [1 2 3] but got %v", received)
+	}
+	for name, expected := range map[string][]float64{"up": {1, 2, 3}, "down": {3, 2, 1}, "empty": {}} {
+		r, err := VarAs[*RangeHandle](vm, "main", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if values, err := r.ToSlice(); err != nil || !reflect.DeepEqual(values, expected) {
+			t.Errorf("Expected %v to be %v but got %v (%v)", name, expected, values, err)
+		}
+		r.Free()
+	}
+	r, _ := VarAs[*RangeHandle](vm, "main", "up")
+	defer r.Free()
+	from, _ := r.From()
+	to, _ := r.To()
+	inclusive, _ := r.IsInclusive()
+	if from != 1 || to != 4 || inclusive {
+		t.Errorf("Expected the range 1...4 but got %v, %v, %v", from, to, inclusive)
+	}
+	if equal, err := ValueEqual(r, []int{1, 2, 3}); !equal || err != nil {
+		t.Errorf("Expected the range to equal [1 2 3] (%v)", err)
+	}
+	for _, name := range []string{"endless", "nan"} {
+		r, err := VarAs[*RangeHandle](vm, "main", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.ToSlice(); !errors.As(err, new(*UnboundedRange)) {
+			t.Errorf("Expected %v to return UnboundedRange but got %v", name, err)
+		}
+		r.Free()
+	}
+}
+
+func TestHandleString(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var inside string
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static print(_)": Method1(func(vm *VM, list *ListHandle) (interface{}, error) {
+				inside = list.String()
+				return nil, nil
+			}),
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static print(list)
+	}
+	class Point {
+		construct new(x, y) {
+			_x = x
+			_y = y
+		}
+		toString { "(%(_x), %(_y))" }
+	}
+	var list = [1, "two", null]
+	var map = {"key": true}
+	var point = Point.new(1, 2)
+	Host.print(list)
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inside != "<list>" {
+		t.Errorf("Expected a list printed while the VM is running to be \"<list>\" but got %q", inside)
+	}
+	list, _ := VarAs[*ListHandle](vm, "main", "list")
+	defer list.Free()
+	m, _ := VarAs[*MapHandle](vm, "main", "map")
+	defer m.Free()
+	point, _ := VarAs[*Handle](vm, "main", "point")
+	defer point.Free()
+	for expected, value := range map[string]fmt.Stringer{"[1, two, null]": list, "{key: true}": m, "(1, 2)": point} {
+		if got := fmt.Sprint(value); got != expected {
+			t.Errorf("Expected %q but got %q", expected, got)
+		}
+	}
+}
+
+func TestHandleEqualsAndSame(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	class Point {
+		construct new(x) { _x = x }
+		x { _x }
+		==(other) { other is Point && other.x == _x }
+	}
+	var a = Point.new(1)
+	var b = Point.new(1)
+	var c = Point.new(2)
+	var d = a
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func(name string) *Handle {
+		h, err := VarAs[*Handle](vm, "main", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	a, b, c, d := get("a"), get("b"), get("c"), get("d")
+	defer vm.FreeAll(a, b, c, d)
+	if equal, err := a.Equals(b); err != nil || !equal {
+		t.Errorf("Expected a to equal b but got %v (%v)", equal, err)
+	}
+	if equal, err := a.Equals(c); err != nil || equal {
+		t.Errorf("Expected a not to equal c but got %v (%v)", equal, err)
+	}
+	if equal, err := a.Equals(1); err != nil || equal {
+		t.Errorf("Expected a not to equal 1 but got %v (%v)", equal, err)
+	}
+	if a.Same(b) {
+		t.Error("Expected a and b not to be the same object")
+	}
+	if !a.Same(d) {
+		t.Error("Expected a and d to be the same object")
+	}
+}
+
+func TestHandleType(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Box": NewClass(func(vm *VM, parameters []interface{}) (interface{}, error) {
+			return 0, nil
+		}, nil, nil),
+	}))
+	err := vm.InterpretString("main", `
+	foreign class Box {
+		construct new() {}
+	}
+	class Point {
+		construct new() {}
+	}
+	var values = [[], {}, 1..2, Box.new(), Fiber.new {}, Point, Fn.new {}, Point.new()]
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, _ := VarAs[*ListHandle](vm, "main", "values")
+	defer values.Free()
+	expected := []struct {
+		typ   WrenType
+		class string
+	}{{TypeList, "List"}, {TypeMap, "Map"}, {TypeRange, "Range"}, {TypeForeign, "Box"}, {TypeFiber, "Fiber"}, {TypeClass, "Point metaclass"}, {TypeFn, "Fn"}, {TypeInstance, "Point"}}
+	for i, e := range expected {
+		element, err := values.Get(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		handle := element.(interface{ Handle() *Handle }).Handle()
+		if got := handle.Type(); got != e.typ {
+			t.Errorf("Expected element %v to be of type %v but got %v", i, e.typ, got)
+		}
+		if name, err := handle.ClassName(); err != nil || name != e.class {
+			t.Errorf("Expected element %v to be of class %q but got %q (%v)", i, e.class, name, err)
+		}
+		vm.FreeAll(element)
+	}
+}
+
+func TestForeignAs(t *testing.T) {
+	type point struct{ x, y int }
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Point": NewClass(func(vm *VM, parameters []interface{}) (interface{}, error) {
+			return &point{1, 2}, nil
+		}, nil, nil),
+	}))
+	err := vm.InterpretString("main", `
+	foreign class Point {
+		construct new() {}
+	}
+	var p = Point.new()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, err := VarAs[*ForeignHandle](vm, "main", "p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Free()
+	if p, err := ForeignAs[*point](h); err != nil || p.x != 1 || p.y != 2 {
+		t.Errorf("Expected the point {1 2} but got %v (%v)", p, err)
+	}
+	if _, err := ForeignAs[string](h); err == nil {
+		t.Error("Expected getting a point as a string to fail")
+	} else if _, ok := err.(*TypeMismatch); !ok {
+		t.Errorf("Expected TypeMismatch but got %v", err)
+	}
+	if !h.Is((*point)(nil)) || h.Is("") {
+		t.Error("Expected the foreign object to only be a *point")
+	}
+}
+
+func TestNewForeign(t *testing.T) {
+	type point struct{ x, y int }
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var finalized []*point
+	vm.SetModule("main", NewModule(ClassMap{
+		"Point": NewClass(nil, func(vm *VM, data interface{}) {
+			finalized = append(finalized, data.(*point))
+		}, MethodMap{
+			"x": Method0(func(vm *VM) (interface{}, error) {
+				return nil, nil
+			}),
+		}),
+		"Host": NewClass(nil, nil, nil),
+	}))
+	err := vm.InterpretString("main", `
+	foreign class Point {
+		foreign x
+	}
+	class Host {}
+	var describe = Fn.new {|p| p is Point }
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &point{3, 4}
+	h, err := vm.NewForeign("main", "Point", p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := ForeignAs[*point](h); err != nil || got != p {
+		t.Errorf("Expected the foreign object to hold %v but got %v (%v)", p, got, err)
+	}
+	describe, _ := VarAs[*FnHandle](vm, "main", "describe")
+	if result, err := describe.Call(h); err != nil || result != true {
+		t.Errorf("Expected the foreign object to be a Point but got %v (%v)", result, err)
+	}
+	describe.Free()
+	h.Free()
+	vm.GC()
+	if len(finalized) != 1 || finalized[0] != p {
+		t.Errorf("Expected the point to be finalized but got %v", finalized)
+	}
+	for _, class := range []string{"Host", "Missing"} {
+		if _, err := vm.NewForeign("main", class, p); err == nil {
+			t.Errorf("Expected creating a %v to fail", class)
+		}
+	}
+}
+
+func TestForeignRegistry(t *testing.T) {
+	vm1 := createConfig(t).NewVM()
+	vm2 := createConfig(t).NewVM()
+	finalized := 0
+	for _, vm := range []*VM{vm1, vm2} {
+		vm.SetModule("main", NewModule(ClassMap{
+			"Box": NewClass(func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return vm.ID(), nil
+			}, func(vm *VM, data interface{}) {
+				if data != vm.ID() {
+					t.Errorf("Expected a box of VM %v to be finalized by it but got %v", data, vm.ID())
+				}
+				finalized++
+			}, nil),
+		}))
+		err := vm.InterpretString("main", `
+		foreign class Box {
+			construct new() {}
+		}
+		var box = Box.new()
+		for (i in 1..100) Box.new()
+		`)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	vm1.GC()
+	if len(vm1.foreigns) != 1 || finalized != 100 {
+		t.Errorf("Expected 1 foreign value to be left after 100 were finalized but got %v and %v", len(vm1.foreigns), finalized)
+	}
+	box, _ := VarAs[*ForeignHandle](vm2, "main", "box")
+	if value, err := box.Get(); err != nil || value != vm2.ID() {
+		t.Errorf("Expected the box to hold %v but got %v (%v)", vm2.ID(), value, err)
+	}
+	box.Free()
+	vm1.Free()
+	vm2.Free()
+	if finalized != 202 {
+		t.Errorf("Expected every box to be finalized but only %v were", finalized)
+	}
+	if vm1.self != 0 {
+		t.Error("Expected freed VMs to release their handle")
+	}
+}
+
+func TestMergeBindsMethods(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var calls []string
+	record := func(name string) ForeignMethodFn {
+		return func(vm *VM, parameters []interface{}) (interface{}, error) {
+			calls = append(calls, name)
+			return nil, nil
+		}
+	}
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{"static a()": record("a"), "b()": record("old b")}),
+	}))
+	vm.Merge(ModuleMap{"main": NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{"b()": record("b")}),
+	})})
+	err := vm.InterpretString("main", `
+	class Host {
+		construct new() {}
+		foreign static a()
+		foreign b()
+	}
+	Host.a()
+	Host.new().b()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(calls, []string{"a", "b"}) {
+		t.Errorf("Expected the merged methods to be called but got %v", calls)
+	}
+}
+
+func TestMergeMissing(t *testing.T) {
+	modules := ModuleMap{"main": NewModule(ClassMap{})}
+	modules.Merge(ModuleMap{
+		"main":  NewModule(ClassMap{"Added": NewClass(nil, nil, MethodMap{"a()": nil})}),
+		"other": NewModule(ClassMap{}),
+	})
+	if modules["other"] == nil || modules["main"].ClassMap["Added"] == nil {
+		t.Errorf("Expected modules and classes missing from the map to be added but got %+v", modules)
+	}
+}
+
+func TestMaxBindingsReached(t *testing.T) {
+	cfg := createConfig(t)
+	var reached error
+	cfg.ErrorFn = func(vm *VM, err error) {
+		if _, ok := err.(*MaxBindingsReached); ok {
+			reached = err
+		}
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	methods := make(MethodMap)
+	var source strings.Builder
+	source.WriteString("class Host {\n")
+	for i := 0; i <= MAX_REGISTRATIONS; i++ {
+		methods[fmt.Sprintf("static m%v()", i)] = func(vm *VM, parameters []interface{}) (interface{}, error) {
+			return nil, nil
+		}
+		fmt.Fprintf(&source, "\tforeign static m%v()\n", i)
+	}
+	source.WriteString("}\n")
+	vm.SetModule("main", NewModule(ClassMap{"Host": NewClass(nil, nil, methods)}))
+	if err := vm.InterpretString("main", source.String()); err == nil {
+		t.Error("Expected binding too many methods to fail")
+	}
+	if reached == nil {
+		t.Error("Expected MaxBindingsReached to be sent to ErrorFn")
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	valid := map[string]Signature{
+		"foo()":           {Kind: SignatureMethod, Name: "foo"},
+		"static foo(_,_)": {Static: true, Kind: SignatureMethod, Name: "foo", Arity: 2},
+		"foo":             {Kind: SignatureGetter, Name: "foo"},
+		"foo=(_)":         {Kind: SignatureSetter, Name: "foo", Arity: 1},
+		"[_,_]":           {Kind: SignatureSubscript, Arity: 2},
+		"[_]=(_)":         {Kind: SignatureSubscriptSetter, Arity: 1},
+		"-":               {Kind: SignaturePrefixOperator, Name: "-"},
+		"-(_)":            {Kind: SignatureInfixOperator, Name: "-", Arity: 1},
+		"==(_)":           {Kind: SignatureInfixOperator, Name: "==", Arity: 1},
+		"...(_)":          {Kind: SignatureInfixOperator, Name: "...", Arity: 1},
+	}
+	for signature, expected := range valid {
+		sig, err := ParseSignature(signature)
+		if err != nil {
+			t.Errorf("Expected %q to be valid but got %v", signature, err)
+		} else if *sig != expected {
+			t.Errorf("Expected %q to parse into %+v but got %+v", signature, expected, *sig)
+		}
+	}
+	for _, signature := range []string{"", "foo(_, _)", "foo(a)", "static", "foo=(_,_)", "[]", "[_", "1foo()", "+(_,_)", "foo(_)bar"} {
+		if _, err := ParseSignature(signature); err == nil {
+			t.Errorf("Expected %q to be invalid", signature)
+		}
+	}
+	module := NewModule(ClassMap{"Host": NewClass(nil, nil, MethodMap{"ok()": nil, "bad(x)": nil, "also bad": nil})})
+	err := module.Validate()
+	if invalid, ok := err.(*InvalidSignatures); !ok || len(invalid.Errors) != 2 || invalid.Errors[0].Signature != "also bad" || invalid.Errors[0].Class != "Host" {
+		t.Errorf("Expected the two bad signatures to be listed but got %v", err)
+	}
+}
+
+func TestSignatureHelpers(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	type vec struct{ x, y float64 }
+	get := func(parameters []interface{}) *vec {
+		v, _ := ForeignAs[*vec](parameters[0].(*ForeignHandle))
+		return v
+	}
+	vm.SetModule("main", NewModule(ClassMap{
+		"Vec": NewClass(func(vm *VM, parameters []interface{}) (interface{}, error) {
+			return &vec{parameters[1].(float64), parameters[2].(float64)}, nil
+		}, nil, MethodMap{
+			Getter("x"): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return get(parameters).x, nil
+			},
+			Setter("x"): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				get(parameters).x = parameters[1].(float64)
+				return nil, nil
+			},
+			Subscript(1): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				if parameters[1] == 0.0 {
+					return get(parameters).x, nil
+				}
+				return get(parameters).y, nil
+			},
+			SubscriptSetter(1): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				get(parameters).y = parameters[2].(float64)
+				return nil, nil
+			},
+			Operator("-", 0): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				v := get(parameters)
+				return v.x + v.y, nil
+			},
+			Operator("==", 1): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return parameters[1] == "vec", nil
+			},
+			Static(Getter("zero")): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return 0, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	foreign class Vec {
+		construct new(x, y) {}
+		foreign x
+		foreign x=(value)
+		foreign [index]
+		foreign [index]=(value)
+		foreign -
+		foreign ==(other)
+		foreign static zero
+	}
+	var v = Vec.new(1, 2)
+	v.x = 3
+	v[1] = 4
+	var result = [v.x, v[0], v[1], -v, v == "vec", Vec.zero]
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	list, err := VarAs[*ListHandle](vm, "main", "result")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Free()
+	result, _ := list.ToSlice(false)
+	if !reflect.DeepEqual(result, []interface{}{3.0, 3.0, 4.0, 7.0, true, 0.0}) {
+		t.Errorf("Expected every helper signature to bind but got %v", result)
+	}
+}
+
+func TestModuleWrenSource(t *testing.T) {
+	type counter struct{ n float64 }
+	module := NewModule(ClassMap{
+		"Counter": NewClass(func(vm *VM, parameters []interface{}) (interface{}, error) {
+			return &counter{parameters[1].(float64)}, nil
+		}, nil, MethodMap{
+			Getter("value"): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				c, _ := ForeignAs[*counter](parameters[0].(*ForeignHandle))
+				return c.n, nil
+			},
+			"add(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				c, _ := ForeignAs[*counter](parameters[0].(*ForeignHandle))
+				c.n += parameters[1].(float64)
+				return nil, nil
+			},
+		}),
+		"Host": NewClass(nil, nil, MethodMap{
+			Static(Operator("-", 0)): func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return -1, nil
+			},
+		}),
+	})
+	module.ClassMap["Counter"].Constructors = []string{"new(_)"}
+	expected := "foreign class Counter {\n\tconstruct new(a0) {}\n\tforeign add(a0)\n\tforeign value\n}\nclass Host {\n\tforeign static -\n}\n"
+	if source := module.WrenSource(); source != expected {
+		t.Errorf("Expected the declarations\n%v\nbut got\n%v", expected, source)
+	}
+	module.Declare = true
+	module.Source = "var start = Counter.new(2)\n"
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("counter", module)
+	err := vm.InterpretString("main", `
+	import "counter" for Counter, Host, start
+	start.add(3)
+	var value = start.value
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, err := VarAs[float64](vm, "main", "value"); err != nil || value != 5 {
+		t.Errorf("Expected the counter to be 5 but got %v (%v)", value, err)
+	}
+}
+
+func TestParseDeclarations(t *testing.T) {
+	classes := ParseDeclarations(`
+	import "other" for Thing
+	// class Commented { foreign skipped() }
+	foreign class Vec is Object {
+		construct new(x, y) {
+			System.print("{ not a member")
+		}
+		construct zero() {}
+		foreign x
+		foreign x=(value)
+		foreign [index]
+		foreign [a, b]=(value)
+		foreign +(other)
+		foreign ==(other)
+		foreign -
+		foreign static from(list)
+		length { (x * x).sqrt }
+	}
+	class Plain {
+		method() {}
+	}
+	class Host {
+		#attribute
+		foreign static log(message, level)
+	}
+	`)
+	expected := []ClassDeclaration{
+		{Name: "Vec", Foreign: true, Constructors: []string{"new(_,_)", "zero()"}, Methods: []string{"x", "x=(_)", "[_]", "[_,_]=(_)", "+(_)", "==(_)", "-", "static from(_)"}},
+		{Name: "Host", Methods: []string{"static log(_,_)"}},
+	}
+	if !reflect.DeepEqual(classes, expected) {
+		t.Errorf("Expected %+v but got %+v", expected, classes)
+	}
+}
+
+func TestModuleBuilder(t *testing.T) {
+	nop := func(vm *VM, parameters []interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	module, err := NewModuleBuilder().
+		Declare().
+		Class("Counter").
+		Constructor(func(vm *VM, parameters []interface{}) (interface{}, error) {
+			return 0.0, nil
+		}, "new()").
+		Method("value", func(vm *VM, parameters []interface{}) (interface{}, error) {
+			return 42, nil
+		}).
+		Class("Host").
+		Static("log(_)", nop).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("counter", module)
+	err = vm.InterpretString("main", `
+	import "counter" for Counter, Host
+	Host.log("hi")
+	var value = Counter.new().value
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, err := VarAs[float64](vm, "main", "value"); err != nil || value != 42 {
+		t.Errorf("Expected 42 but got %v (%v)", value, err)
+	}
+	_, err = NewModuleBuilder().
+		Class("A").Method("static f()", nop).Static("f()", nop).Method("bad(x)", nop).
+		Class("A").
+		Build()
+	buildErr, ok := err.(*ModuleBuildError)
+	if !ok || len(buildErr.Errors) != 3 {
+		t.Errorf("Expected a duplicate method, a duplicate class and a bad signature but got %v", err)
+	}
+}
+
+func TestRebindMethod(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static greeting": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return "hello", nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static greeting
+	}
+	var greet = Fn.new { Host.greeting }
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	greet, _ := VarAs[*FnHandle](vm, "main", "greet")
+	defer greet.Free()
+	err = vm.RebindMethod("main", "Host", "static greeting", func(vm *VM, parameters []interface{}) (interface{}, error) {
+		return "goodbye", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result, err := greet.Call(); err != nil || result != "goodbye" {
+		t.Errorf("Expected the rebound method to return \"goodbye\" but got %v (%v)", result, err)
+	}
+	vm.Reset()
+	if err := vm.RebindMethod("main", "Host", "greeting", nil); err == nil {
+		t.Error("Expected rebinding to nil to fail")
+	}
+	if err := vm.RebindMethod("main", "Host", "farewell", func(vm *VM, parameters []interface{}) (interface{}, error) {
+		return nil, nil
+	}); err == nil {
+		t.Error("Expected rebinding a method that isn't in the module to fail")
+	}
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "main.wren")
+	write := func(source string, age time.Duration) {
+		writeFiles(t, dir, map[string]string{"main.wren": source})
+		stamp := time.Now().Add(age)
+		if err := os.Chtimes(file, stamp, stamp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("var Value = 1", -time.Hour)
+	reloader := NewReloader(NewVM())
+	defer func() { reloader.VM().Free() }()
+	if err := reloader.Watch("main", file); err != nil {
+		t.Fatal(err)
+	}
+	if err := reloader.Load(); err != nil {
+		t.Fatal(err)
+	}
+	value := reloader.Retain("main", "Value")
+	if value.Value() != 1.0 {
+		t.Fatalf("expected 1, got %v (%v)", value.Value(), value.Err())
+	}
+	if reloaded, err := reloader.Poll(); reloaded || err != nil {
+		t.Fatalf("expected no reload, got %v, %v", reloaded, err)
+	}
+	var changed []string
+	reloader.OnReload = func(vm *VM, files []string, err error) { changed = files }
+	write("var Value = 2", 0)
+	if reloaded, err := reloader.Poll(); !reloaded || err != nil {
+		t.Fatalf("expected reload, got %v, %v", reloaded, err)
+	}
+	if value.Value() != 2.0 || len(changed) != 1 || changed[0] != file {
+		t.Fatalf("expected 2 from %v, got %v from %v", file, value.Value(), changed)
+	}
+	// With NewVM set, a reload that fails keeps the previous VM running
+	reloader.NewVM = NewVM
+	before := reloader.VM()
+	write("var Value = ", time.Hour)
+	if _, err := reloader.Poll(); err == nil {
+		t.Fatal("expected compile error")
+	}
+	if reloader.VM() != before || value.Value() != 2.0 {
+		t.Fatalf("expected previous VM to be kept, got %v", value.Value())
+	}
+	write("var Value = 3", 2*time.Hour)
+	if _, err := reloader.Poll(); err != nil {
+		t.Fatal(err)
+	}
+	if reloader.VM() == before || value.Value() != 3.0 {
+		t.Fatalf("expected new VM with 3, got %v", value.Value())
+	}
+}
+
+func TestJSONModule(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("json", NewJSONModule())
+	err := vm.InterpretString("main", `
+	import "json" for Json
+	var data = Json.parse("{\"name\": \"wren\", \"tags\": [1, true, null, \"<b>\"]}")
+	var name = data["name"]
+	var count = data["tags"].count
+	var text = Json.stringify(data["tags"])
+	var object = Json.stringify({"a": [1.5]})
+	var pretty = Json.stringify({"a": 1}, "  ")
+	var bad = Fiber.new { Json.parse("{") }.try()
+	var badKey = Fiber.new { Json.stringify({1: 2}) }.try()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"name": "wren", "count": 4.0, "text": `[1,true,null,"<b>"]`, "object": `{"a":[1.5]}`, "pretty": "{\n  \"a\": 1\n}"}
+	for name, value := range expected {
+		if v, _ := vm.GetVariable("main", name); v != value {
+			t.Errorf("Expected %v to be %q but got %q", name, value, v)
+		}
+	}
+	for _, name := range []string{"bad", "badKey"} {
+		if v, _ := vm.GetVariable("main", name); v == nil {
+			t.Errorf("Expected %v to be an error", name)
+		}
+	}
+}
+
+func TestTimerModule(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("timer", NewTimerModule())
+	err := vm.InterpretString("main", `
+	import "timer" for Timer
+	var Log = []
+	Fiber.new {
+		Timer.sleep(20)
+		Log.add("slow")
+	}.call()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Poll(); err != nil || vm.PendingTimers() != 1 {
+		t.Fatalf("Expected one pending timer, got %v (%v)", vm.PendingTimers(), err)
+	}
+	// Other scripts can run while the first fiber sleeps
+	err = vm.InterpretString("other", `
+	import "main" for Log
+	import "timer" for Timer
+	Log.add("start")
+	Timer.sleep(0)
+	Log.add("fast")
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.Run(); err != nil {
+		t.Fatal(err)
+	}
+	log, err := VarAs[*ListHandle](vm, "main", "Log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer log.Free()
+	values, _ := log.ToSlice(false)
+	if fmt.Sprint(values) != "[start fast slow]" || vm.PendingTimers() != 0 {
+		t.Errorf("Unexpected order %v", values)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	clock := NewModule(ClassMap{
+		"Clock": NewClass(nil, nil, MethodMap{
+			"static epoch": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return time.Date(2020, time.March, 1, 12, 30, 0, 0, time.UTC), nil
+			},
+		}),
+	})
+	clock.Declare = true
+	vm.SetModule("clock", clock)
+	err := vm.InterpretString("main", `
+	import "wrengo/datetime" for DateTime
+	import "clock" for Clock
+	var epoch = Clock.epoch
+	var year = epoch.year
+	var text = epoch.add(90).format(DateTime.rfc3339)
+	var later = epoch.addDate(0, 1, 0)
+	var diff = later - epoch
+	var before = epoch < later
+	var same = epoch == DateTime.parse(DateTime.rfc3339, "2020-03-01T12:30:00Z")
+	var built = DateTime.new(2021, 2, 3).day
+	var unix = DateTime.unix(86400).utc.toString
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"year": 2020.0, "text": "2020-03-01T12:31:30Z", "diff": 31 * 24 * 3600.0, "before": true, "same": true, "built": 3.0, "unix": "1970-01-02T00:00:00Z"}
+	for name, value := range expected {
+		if v, _ := vm.GetVariable("main", name); v != value {
+			t.Errorf("Expected %v to be %v but got %v", name, value, v)
+		}
+	}
+	later, err := VarAs[*ForeignHandle](vm, "main", "later")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer later.Free()
+	var got time.Time
+	if err := vm.Unmarshal(later, &got); err != nil || got.Month() != time.April {
+		t.Errorf("Expected April but got %v (%v)", got, err)
+	}
+
+	// modules of WrenGo don't take names scripts may use
+	other := createConfig(t).NewVM()
+	defer other.Free()
+	module := NewModule(nil)
+	module.Source = "var Own = true"
+	other.SetModule("datetime", module)
+	err = other.InterpretString("main", `
+	import "datetime" for Own
+	var own = Own
+	`)
+	if own, _ := other.GetVariable("main", "own"); err != nil || own != true {
+		t.Errorf("Expected a module set as \"datetime\" to be imported but got %v, %v", own, err)
+	}
+}
+
+func TestMsgpack(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	var Data = {"name": "wren", "list": [1, -5, 300, -70000, 1.5, true, null, "x" * 40], 3: false}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := VarAs[*MapHandle](vm, "main", "Data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer data.Free()
+	encoded, err := EncodeMsgpack(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// decode into another VM
+	other := createConfig(t).NewVM()
+	defer other.Free()
+	decoded, err := DecodeMsgpack(other, encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.FreeAll(decoded)
+	result, err := decoded.(*MapHandle).ToMap(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[interface{}]interface{}{"name": "wren", "list": []interface{}{1.0, -5.0, 300.0, -70000.0, 1.5, true, nil, strings.Repeat("x", 40)}, 3.0: false}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+	encodedAgain, _ := EncodeMsgpack(result)
+	if len(encodedAgain) != len(encoded) {
+		t.Errorf("Expected Go values to encode to %v bytes but got %v", len(encoded), len(encodedAgain))
+	}
+	var msgpackErr *MsgpackError
+	if _, err := DecodeMsgpack(other, []byte{0x92, 0x01}); !errors.As(err, &msgpackErr) {
+		t.Errorf("Expected MsgpackError for truncated data but got %v", err)
+	}
+	if _, err := DecodeMsgpack(other, []byte{0xc7, 0x00, 0x01}); !errors.As(err, &msgpackErr) {
+		t.Errorf("Expected MsgpackError for extension type but got %v", err)
+	}
+	deep := bytes.Repeat([]byte{0x91}, MsgpackMaxDepth+1)
+	if _, err := DecodeMsgpack(other, append(deep, 0xc0)); !errors.As(err, &msgpackErr) {
+		t.Errorf("Expected MsgpackError for deeply nested arrays but got %v", err)
+	}
+	if err := vm.InterpretString("main", "var Cyclic = [1]\nCyclic.add(Cyclic)"); err != nil {
+		t.Fatal(err)
+	}
+	cyclic, err := VarAs[*ListHandle](vm, "main", "Cyclic")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cyclic.Free()
+	var cyclicErr *CyclicValue
+	if _, err := EncodeMsgpack(cyclic); !errors.As(err, &cyclicErr) {
+		t.Errorf("Expected CyclicValue for a list containing itself but got %v", err)
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	source := createConfig(t).NewVM()
+	defer source.Free()
+	target := createConfig(t).NewVM()
+	defer target.Free()
+	err := source.InterpretString("main", `
+	var Shared = [1, 2]
+	var Data = {"name": "wren", "items": [Shared, Shared, {"ok": true}], 5: null}
+	var Cyclic = [1]
+	Cyclic.add(Cyclic)
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := VarAs[*MapHandle](source, "main", "Data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer data.Free()
+	copied, err := source.Transfer(data, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer target.FreeAll(copied)
+	copiedMap, ok := copied.(*MapHandle)
+	if !ok || copiedMap.VM() != target {
+		t.Fatalf("Expected a map in the target VM but got %v", copied)
+	}
+	result, err := copiedMap.ToMap(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[interface{}]interface{}{"name": "wren", "items": []interface{}{[]interface{}{1.0, 2.0}, []interface{}{1.0, 2.0}, map[interface{}]interface{}{"ok": true}}, 5.0: nil}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+	cyclic, _ := VarAs[*ListHandle](source, "main", "Cyclic")
+	defer cyclic.Free()
+	var cyclicErr *CyclicValue
+	if _, err := source.Transfer(cyclic, target); !errors.As(err, &cyclicErr) {
+		t.Errorf("Expected CyclicValue but got %v", err)
+	}
+	var mismatch *NonMatchingVM
+	if _, err := target.Transfer(data, source); !errors.As(err, &mismatch) {
+		t.Errorf("Expected NonMatchingVM but got %v", err)
+	}
+}
+
+func TestMailbox(t *testing.T) {
+	mailbox := NewMailbox()
+	if err := mailbox.Post("jobs", map[string]interface{}{"id": 1, "tags": []string{"a", "b"}}); err != nil {
+		t.Fatal(err)
+	}
+	worker := createConfig(t).NewVM()
+	defer worker.Free()
+	worker.SetModule("mailbox", mailbox.Module("jobs", "results"))
+	err := worker.InterpretString("main", `
+	import "mailbox" for Channel
+	var jobs = Channel.open("jobs")
+	var results = Channel.open("results")
+	var job = jobs.receive()
+	results.send({"id": job["id"], "tags": job["tags"].count, "done": true, "job": {"tags": job["tags"]}})
+	var empty = jobs.receive() == null && jobs.isEmpty
+	var denied = Fiber.new { Channel.open("secrets") }.try()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := worker.GetVariable("main", "empty"); v != true {
+		t.Error("Expected jobs channel to be empty")
+	}
+	if v, _ := worker.GetVariable("main", "denied"); v != `This VM was not given the channel "secrets"` {
+		t.Errorf("Expected channel to be denied but got %v", v)
+	}
+	// another VM can read what the worker sent
+	reader := createConfig(t).NewVM()
+	defer reader.Free()
+	reader.SetModule("mailbox", mailbox.Module())
+	err = reader.InterpretString("main", `
+	import "mailbox" for Channel
+	var Result = Channel.open("results").receive()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := VarAs[*MapHandle](reader, "main", "Result")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer result.Free()
+	values, _ := result.ToMap(true)
+	expected := map[interface{}]interface{}{"id": 1.0, "tags": 2.0, "done": true, "job": map[interface{}]interface{}{"tags": []interface{}{"a", "b"}}}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected %v but got %v", expected, values)
+	}
+	if err := mailbox.Post("jobs", struct{}{}); err == nil {
+		t.Error("Expected structs to be rejected")
+	}
+}
+
+func TestChannel(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	input := make(chan int, 1)
+	output := make(chan string, 4)
+	vm.SetModule("host", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static input": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return vm.NewChannel(input)
+			},
+			"static output": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				return vm.NewChannel((chan<- string)(output))
+			},
+		}),
+	}))
+	vm.moduleMap["host"].Declare = true
+	input <- 1
+	err := vm.InterpretString("main", `
+	import "wrengo/channel"
+	import "host" for Host
+	var input = Host.input
+	var output = Host.output
+	while (true) {
+		var value = input.receive()
+		if (value == null) break
+		output.send("got %(value)")
+	}
+	output.send("closed")
+	var wrongWay = Fiber.new { output.receive() }.try()
+	output.send(wrongWay)
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the first value was already there so the script is now waiting for the second
+	if vm.PendingReceives() != 1 || len(output) != 1 {
+		t.Fatalf("Expected one waiting fiber, got %v and %v outputs", vm.PendingReceives(), len(output))
+	}
+	go func() {
+		input <- 2
+		close(input)
+	}()
+	if err := vm.Run(); err != nil {
+		t.Fatal(err)
+	}
+	close(output)
+	var got []string
+	for value := range output {
+		got = append(got, value)
+	}
+	if fmt.Sprint(got) != "[got 1 got 2 closed Channel of direction chan<- cannot be used this way]" {
+		t.Errorf("Unexpected output %q", got)
+	}
+
+	// sending to a channel that nothing receives from yet doesn't block the VM
+	unbuffered := make(chan string)
+	sendTo, err := vm.NewChannel(unbuffered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sendTo.Free()
+	if err := vm.InterpretString("main", `
+	class Sender {
+		static run(channel) {
+			channel.send("first")
+			channel.send("second")
+		}
+	}
+	`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vm.CallStatic("main", "Sender", "run(_)", sendTo); err != nil {
+		t.Fatal(err)
+	}
+	if vm.PendingSends() != 1 {
+		t.Fatalf("Expected the fiber to wait for the channel, got %v waiting", vm.PendingSends())
+	}
+	received := make(chan []string)
+	go func() {
+		received <- []string{<-unbuffered, <-unbuffered}
+	}()
+	if err := vm.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-received; fmt.Sprint(got) != "[first second]" || vm.PendingSends() != 0 {
+		t.Errorf("Expected [first second] to be received but got %q and %v waiting", got, vm.PendingSends())
+	}
+	close(unbuffered)
+	if _, err := vm.CallStatic("main", "Sender", "run(_)", sendTo); err == nil || !strings.Contains(err.Error(), (&ChannelClosed{}).Error()) {
+		t.Errorf("Expected sending to a closed channel to abort with ChannelClosed but got %v", err)
+	}
+}
+
+func TestEventLoop(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	release := make(chan struct{})
+	io := NewModule(ClassMap{
+		"IO": NewClass(nil, nil, MethodMap{
+			"static read_(_,_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				name := parameters[1].(string)
+				return Null, vm.Async(parameters[2].(*FiberHandle), func() (interface{}, error) {
+					<-release
+					if name == "missing" {
+						return nil, errors.New("Not found")
+					}
+					return "contents of " + name, nil
+				})
+			},
+		}),
+	})
+	io.Source = `
+	class IO {
+		static read(name) {
+			read_(name, Fiber.current)
+			return Fiber.suspend()
+		}
+		foreign static read_(name, fiber)
+	}
+	`
+	vm.SetModule("io", io)
+	err := vm.InterpretString("main", `
+	import "io" for IO
+	var Log = []
+	Fiber.new { Log.add(IO.read("a.txt")) }.call()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Suspending stops the VM, so the next read starts once the interpretation returns
+	err = vm.InterpretString("other", `
+	import "main" for Log
+	import "io" for IO
+	var fiber = Fiber.new { IO.read("missing") }
+	fiber.try()
+	Log.add(fiber.error)
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vm.Pending() != 2 {
+		t.Fatalf("Expected 2 pending operations, got %v", vm.Pending())
+	}
+	// Nothing is done yet so the loop times out
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := vm.RunContext(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("Expected the loop to time out, got %v", err)
+	}
+	go vm.Post(func(vm *VM) error {
+		return vm.InterpretString("main", `Log.add("posted")`)
+	})
+	close(release)
+	if err := vm.Run(); err != nil {
+		t.Fatal(err)
+	}
+	list, err := VarAs[*ListHandle](vm, "main", "Log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Free()
+	values, _ := list.ToSlice(false)
+	var log []string
+	for _, value := range values {
+		log = append(log, fmt.Sprint(value))
+	}
+	sort.Strings(log)
+	if fmt.Sprint(log) != "[Not found contents of a.txt posted]" || vm.Pending() != 0 {
+		t.Errorf("Unexpected log %q", log)
+	}
+}
+
+func TestTick(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("timer", NewTimerModule())
+	err := vm.InterpretString("main", `
+	import "timer" for Timer
+	var Log = []
+	class World {
+		static update(dt) { Log.add("world %(dt)") }
+	}
+	class Player {
+		construct new(name) { _name = name }
+		update(dt) {
+			if (dt > 1) Fiber.abort("%(_name) fell behind")
+			Log.add("%(_name) %(dt)")
+		}
+	}
+	var Hero = Player.new("hero")
+	Fiber.new {
+		Timer.sleep(0)
+		Log.add("woke")
+	}.call()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	world, err := vm.RegisterActor("main", "World")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vm.RegisterActor("main", "Hero"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vm.RegisterActor("main", "Missing"); err == nil {
+		t.Error("Expected registering a missing variable to fail")
+	}
+	if err := vm.Tick(0.5); err != nil {
+		t.Fatal(err)
+	}
+	world.Remove()
+	if err := vm.Tick(2); err == nil || vm.Actors() != 1 {
+		t.Errorf("Expected the second tick to fail, got %v", err)
+	}
+	list, err := VarAs[*ListHandle](vm, "main", "Log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer list.Free()
+	values, _ := list.ToSlice(false)
+	if fmt.Sprint(values) != "[woke world 0.5 hero 0.5]" {
+		t.Errorf("Unexpected log %v", values)
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `
+	#!route = "/users"
+	#!route = "/people"
+	#!doc(summary = "Users", hidden)
+	#compileOnly
+	class Users {
+		#!method = "GET"
+		static list() {}
+		#!json(skip = true)
+		name { "" }
+	}
+	class Plain {}
+	#!tag
+	class Tagged {}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tagged, err := vm.Attributes("main", "Tagged"); err != nil || !tagged.Self.Has("", "tag") || len(tagged.Methods) != 0 {
+		t.Errorf("Expected only the class to have attributes but got %v (%v)", tagged, err)
+	}
+	attributes, err := vm.Attributes("main", "Users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(attributes.Self[""]["route"]) != "[/users /people]" {
+		t.Errorf("Unexpected routes %v", attributes.Self[""]["route"])
+	}
+	if summary, _ := attributes.Self.Get("doc", "summary"); summary != "Users" || !attributes.Self.Has("doc", "hidden") {
+		t.Errorf("Unexpected doc group %v", attributes.Self["doc"])
+	}
+	if attributes.Self.Has("", "compileOnly") {
+		t.Error("Expected attributes without ! to be discarded")
+	}
+	if method, _ := attributes.Methods["static list()"].Get("", "method"); method != "GET" {
+		t.Errorf("Unexpected method attributes %v", attributes.Methods)
+	}
+	if skip, _ := attributes.Methods["name"].Get("json", "skip"); skip != true {
+		t.Errorf("Unexpected method attributes %v", attributes.Methods)
+	}
+	plain, err := vm.Attributes("main", "Plain")
+	if err != nil || len(plain.Self) != 0 || len(plain.Methods) != 0 {
+		t.Errorf("Expected no attributes, got %v (%v)", plain, err)
+	}
+}
+
+func TestMetaModule(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `import "meta" for Meta`)
+	var traced *RuntimeErrorWithTrace
+	if !errors.As(err, &traced) || traced.Message != (&ModuleDisabled{Module: "meta"}).Error() {
+		t.Errorf("Expected meta to be disabled, got %v", err)
+	}
+
+	cfg := createConfig(t)
+	cfg.EnableMeta = true
+	var reported []error
+	cfg.ErrorFn = func(vm *VM, err error) {
+		reported = append(reported, err)
+	}
+	vm = cfg.NewVM()
+	defer vm.Free()
+	err = vm.InterpretString("main", `
+	import "meta" for Meta
+	var Total = 1
+	Meta.eval("Total = Total + 1")
+	var Double = Meta.compileExpression("Total * 2").call()
+	var Names = Meta.getModuleVariables("main")
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if double, err := VarAs[float64](vm, "main", "Double"); double != 4 {
+		t.Errorf("Expected eval to change Total, got %v (%v)", double, err)
+	}
+	err = vm.InterpretString("main", `Meta.eval("var = 1")`)
+	if !errors.As(err, &traced) || len(traced.Diagnostics) == 0 || !strings.HasPrefix(traced.Message, "Could not compile source code: [main line 1]") {
+		t.Fatalf("Expected a structured compile error, got %#v", err)
+	}
+	var compileErr *CompileError
+	if len(reported) == 0 || !errors.As(reported[0], &compileErr) || compileErr.Module() != "main" {
+		t.Errorf("Expected the compile error to be sent to ErrorFn, got %v", reported)
+	}
+}
+
+func TestDisableRandom(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.DisableRandom = true
+	vm := cfg.NewVM()
+	defer vm.Free()
+	err := vm.InterpretString("main", `import "random" for Random`)
+	var traced *RuntimeErrorWithTrace
+	if !errors.As(err, &traced) || traced.Message != (&ModuleDisabled{Module: "random"}).Error() {
+		t.Errorf("Expected random to be disabled, got %v", err)
+	}
+	vm = createConfig(t).NewVM()
+	defer vm.Free()
+	if err := vm.InterpretString("main", `import "random" for Random
+	Random.new(1).float()`); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSandboxPolicy(t *testing.T) {
+	cfg := createConfig(t)
+	var denied []string
+	cfg.ErrorFn = func(vm *VM, err error) {
+		var importErr *ImportDenied
+		if errors.As(err, &importErr) {
+			denied = append(denied, err.Error())
+		}
+	}
+	cfg.Sandbox = &SandboxPolicy{
+		AllowImports: []string{"lib/*", "random", "secret"},
+		DenyImports:  []string{"random"},
+		MaxModules:   2,
+		ImportHook: func(vm *VM, importer, name string) error {
+			if name == "secret" {
+				return errors.New("secrets are off limits")
+			}
+			return nil
+		},
+		ForeignClassHook: func(vm *VM, module, class string) error {
+			if class == "Locked" {
+				return errors.New("Locked is sandboxed")
+			}
+			return nil
+		},
+	}
+	cfg.LoadModuleFn = func(vm *VM, name string) (string, bool) {
+		return "var Name = \"" + name + "\"", true
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", NewModule(ClassMap{"Locked": NewClass(nil, nil, nil)}))
+	for _, module := range []string{"lib/a", "lib/a", "secret", "lib/b", "lib/c", "other", "random"} {
+		vm.InterpretString("main", `import "`+module+`"`)
+	}
+	expected := []string{
+		(&ImportDenied{Module: "secret", Reason: errors.New("secrets are off limits")}).Error(),
+		(&ImportDenied{Module: "lib/c", Reason: &TooManyModules{Max: 2}}).Error(),
+		(&ImportDenied{Module: "other"}).Error(),
+		(&ImportDenied{Module: "random"}).Error(),
+	}
+	if fmt.Sprint(denied) != fmt.Sprint(expected) {
+		t.Errorf("Expected %q to be denied, got %q", expected, denied)
+	}
+	err := vm.InterpretString("main", `
+	foreign class Locked {
+		construct new() {}
+	}
+	Locked.new()
+	`)
+	var traced *RuntimeErrorWithTrace
+	if !errors.As(err, &traced) || traced.Message != "Locked is sandboxed" {
+		t.Errorf("Expected constructing Locked to fail, got %v", err)
+	}
+}
+
+func TestInterpretStringWithArgs(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	err := vm.InterpretStringWithArgs("main", `var Greeting = "hello " + Args.join(" ")`, []string{"a", "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = vm.InterpretStringWithArgs("main", `var Count = Args.count`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	greeting, _ := VarAs[string](vm, "main", "Greeting")
+	count, _ := VarAs[float64](vm, "main", "Count")
+	if greeting != "hello a b" || count != 0 {
+		t.Errorf("Unexpected greeting %q and count %v", greeting, count)
+	}
+}
+
+func TestInterpretReader(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.MaxSourceBytes = 32
+	vm := cfg.NewVM()
+	defer vm.Free()
+	if err := vm.InterpretReader("main", strings.NewReader("#!/usr/bin/env wren\nvar X = 1")); err != nil {
+		t.Fatal(err)
+	}
+	if x, _ := VarAs[float64](vm, "main", "X"); x != 1 {
+		t.Errorf("Expected X to be 1, got %v", x)
+	}
+	// "#!" on the first line is only a shebang if a path follows
+	if err := vm.InterpretReader("attributed", strings.NewReader("#!tag = 1\nclass Z {}")); err != nil {
+		t.Fatal(err)
+	}
+	if attributes, err := vm.Attributes("attributed", "Z"); err != nil || !attributes.Self.Has("", "tag") {
+		t.Errorf("Expected an attribute on the first line to be kept, got %v (%v)", attributes, err)
+	}
+	err := vm.InterpretReader("big", strings.NewReader("var Y = \""+strings.Repeat("y", 32)+"\""))
+	var tooLarge *SourceTooLarge
+	if !errors.As(err, &tooLarge) || tooLarge.Max != 32 || vm.HasModule("big") {
+		t.Errorf("Expected SourceTooLarge, got %v", err)
+	}
+	err = vm.InterpretFileFS(fstest.MapFS{"big.wren": {Data: []byte(strings.Repeat("\n", 33))}}, "big.wren")
+	if !errors.As(err, &tooLarge) {
+		t.Errorf("Expected the limit to apply to files, got %v", err)
+	}
+}
+
+func TestCompileCheck(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	if err := vm.CompileCheck("main", `System.print("not run")
+	var X = 1`); err != nil {
+		t.Fatal(err)
+	}
+	if vm.HasModule("main") {
+		t.Error("Expected the module not to be created")
+	}
+	err := vm.CompileCheck("broken", "var = 1\nclass {")
+	var compileErr *ResultCompileError
+	if !errors.As(err, &compileErr) || len(compileErr.Diagnostics) < 2 {
+		t.Fatalf("Expected diagnostics, got %v", err)
+	}
+	if first := compileErr.Diagnostics[0]; first.Module() != "broken" || first.Line() != 1 {
+		t.Errorf("Unexpected diagnostic %v", first)
+	}
+}
+
+func TestCheckAll(t *testing.T) {
+	fsys := fstest.MapFS{
+		"good.wren":    {Data: []byte("var X = 1")},
+		"bad.wren":     {Data: []byte("var X = 1\nvar = 2")},
+		"lib/bad.wren": {Data: []byte("class {")},
+		"notes.txt":    {Data: []byte("not wren")},
+		"worse.wren":   {Data: []byte("#!/usr/bin/env wren\nSystem.print(")},
+	}
+	diagnostics, err := CheckAll(fsys, "*.wren")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []string
+	for _, diagnostic := range diagnostics {
+		files = append(files, fmt.Sprintf("%v:%v", diagnostic.Module(), diagnostic.Line()))
+	}
+	if len(files) == 0 || files[0] != "bad.wren:2" || files[len(files)-1] != "worse.wren:2" {
+		t.Errorf("Unexpected diagnostics %v", diagnostics)
+	}
+	if _, err := CheckAll(fsys, "["); err == nil {
+		t.Error("Expected a bad pattern to fail")
+	}
+}
+
+func TestCallStack(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var stack []StackFrame
+	vm.SetModule("main", NewModule(ClassMap{
+		"Probe": NewClass(nil, nil, MethodMap{
+			"static here()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				stack = vm.CallStack()
+				return Null, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Probe {
+		foreign static here()
+	}
+	class Outer {
+		static run() {
+			Probe.here()
+		}
+	}
+	Outer.run()
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(stack) != "[{main 0 run()} {main 10 (script)}]" {
+		t.Errorf("Unexpected stack %v", stack)
+	}
+	if vm.CallStack() != nil {
+		t.Error("Expected no stack while the VM isn't running")
+	}
+}
+
+func TestREPL(t *testing.T) {
+	cfg := createConfig(t)
+	var output strings.Builder
+	cfg.WriteFn = func(vm *VM, text string) {
+		output.WriteString(text)
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	repl := NewREPL(vm, "repl")
+	lines := []string{
+		`var a = 1`,
+		`a + 1`,
+		`class Adder {`,
+		`	static add(x, y) { x + y }`,
+		`}`,
+		`Adder.add(a, "%(a`,
+		`)".count) // a comment`,
+		``,
+		`System.print("hi")`,
+		`[1, 2].map {|n| n * 2 }.toList`,
+	}
+	for _, line := range lines {
+		if _, err := repl.Eval(line); err != nil {
+			t.Fatal(err)
+		}
+		if line == "class Adder {" && !repl.Continuing() {
+			t.Error("Expected the REPL to wait for the rest of the class")
+		}
+	}
+	if output.String() != "2\n2\nhi\n[2, 4]\n" {
+		t.Errorf("Unexpected output %q", output.String())
+	}
+	var redefined *VariableRedefined
+	if _, err := repl.Eval(`var a = 2`); !errors.As(err, &redefined) {
+		t.Errorf("Expected VariableRedefined but got %v", err)
+	}
+	output.Reset()
+	in := strings.NewReader("var b = [\n  a\n]\nb\n")
+	var out strings.Builder
+	if err := repl.Run(in, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "> ... ... > > \n" || output.String() != "[1]\n" {
+		t.Errorf("Unexpected prompts %q and output %q", out.String(), output.String())
+	}
+}
+
+func TestBundle(t *testing.T) {
+	fsys := fstest.MapFS{
+		"main.wren": {Data: []byte(`import "lib/greet" for Greeter, Name as Who
+import "timer" for Timer
+var greeting = Greeter.greet(Who)
+`)},
+		"lib/greet.wren": {Data: []byte(`import "./names" for Name
+import "timer" for Timer
+class Greeter {
+	static greet(name) { "Hello %(name)!" }
+}
+`)},
+		"lib/names.wren": {Data: []byte(`var Name = "bundle"`)},
+		"other.wren":     {Data: []byte(`var greeting = 1`)},
+		"clash.wren":     {Data: []byte(`import "other"
+var greeting = 2`)},
+	}
+	loader := FSModuleLoader(fsys, ".")
+	source, err := Bundle("main", loader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	vm.SetModule("timer", NewTimerModule())
+	if err := vm.InterpretString("bundle", source); err != nil {
+		t.Fatalf("%v in bundle:\n%v", err, source)
+	}
+	if greeting, _ := vm.GetVariable("bundle", "greeting"); greeting != "Hello bundle!" {
+		t.Errorf("Unexpected greeting %v", greeting)
+	}
+	if strings.Count(source, `import "timer"`) != 1 || strings.Contains(source, `import "lib`) || strings.Contains(source, `import "./`) {
+		t.Errorf("Unexpected imports in bundle:\n%v", source)
+	}
+	var conflict *BundleConflict
+	if _, err := Bundle("clash", loader); !errors.As(err, &conflict) || conflict.Name != "greeting" {
+		t.Errorf("Expected BundleConflict but got %v", err)
+	}
+	var notFound *ModuleNotFound
+	if _, err := Bundle("missing", loader); !errors.As(err, &notFound) {
+		t.Errorf("Expected ModuleNotFound but got %v", err)
+	}
+}
+
+func TestHandleOwnership(t *testing.T) {
+	for _, autoFree := range []bool{false, true} {
+		cfg := createConfig(t)
+		cfg.AutoFreeHandles = autoFree
+		vm := cfg.NewVM()
+		if err := vm.InterpretString("main", `var list = [1, 2]`); err != nil {
+			t.Fatal(err)
+		}
+		list, err := VarAs[*ListHandle](vm, "main", "list")
+		if err != nil {
+			t.Fatal(err)
+		}
+		sibling := *list.Handle()
+		sibling.Free()
+		list.Free()
+		if _, err := list.Handle().Copy(); !errors.As(err, new(*NilHandleError)) {
+			t.Errorf("Expected NilHandleError after a copy was freed but got %v", err)
+		}
+		stale, err := VarAs[*ListHandle](vm, "main", "list")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.Reset(); err != nil {
+			t.Fatal(err)
+		}
+		if err := vm.InterpretString("main", `var list = []`); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := stale.Count(); !errors.As(err, new(*NilHandleError)) {
+			t.Errorf("Expected NilHandleError after a reset but got %v", err)
+		}
+		fresh, err := VarAs[*ListHandle](vm, "main", "list")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := fresh.Insert(stale); !errors.As(err, new(*NilHandleError)) {
+			t.Errorf("Expected NilHandleError when passing a stale handle but got %v", err)
+		}
+		stale.Free()
+		if count, err := fresh.Count(); err != nil || count != 0 {
+			t.Errorf("Expected the new list to be untouched but got %v, %v", count, err)
+		}
+		fresh.Free()
+		vm.Free()
+		if _, err := fresh.Count(); !errors.As(err, new(*NilHandleError)) {
+			t.Errorf("Expected NilHandleError after the VM was freed but got %v", err)
+		}
+	}
+}
+
+func TestPinToThread(t *testing.T) {
+	cfg := createConfig(t)
+	cfg.PinToThread = true
+	vm := cfg.NewVM()
+	threads := map[int64]int{}
+	vm.SetModule("main", NewModule(ClassMap{
+		"Probe": NewClass(nil, nil, MethodMap{
+			"static here()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				threads[threadID()]++
+				return Null, nil
+			},
+			"==(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				threads[threadID()]++
+				return true, nil
+			},
+		}),
+	}))
+	if err := vm.InterpretString("main", `
+	class Probe {
+		construct new() {}
+		foreign static here()
+		foreign ==(other)
+	}
+	var probe = Probe.new()`); err != nil {
+		t.Fatal(err)
+	}
+	probe, err := VarAs[*Handle](vm, "main", "probe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error)
+	for i := 0; i < 8; i++ {
+		go func() {
+			done <- vm.InterpretString("main", `Probe.here()`)
+		}()
+		go func() {
+			_, err := vm.CallStatic("main", "Probe", "here()")
+			done <- err
+		}()
+		go func() {
+			if _, err := probe.Equals(probe); err != nil {
+				done <- err
+				return
+			}
+			list, err := vm.NewList()
+			if err != nil {
+				done <- err
+				return
+			}
+			err = list.Insert(1.0)
+			count, _ := list.Count()
+			list.Free()
+			if err == nil && count != 1 {
+				err = fmt.Errorf("Expected a list of 1 element but got %v", count)
+			}
+			done <- err
+		}()
+	}
+	for i := 0; i < 24; i++ {
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}
+	if err := vm.Do(func(vm *VM) error {
+		if !vm.thread.current() {
+			t.Error("Expected Do to run on the VM's thread")
+		}
+		return nil
+	}); err != nil {
+		t.Error(err)
+	}
+	probe.Free()
+	thread := vm.thread
+	vm.Free()
+	if len(threads) != 1 || threads[thread.id] != 24 {
+		t.Errorf("Expected every foreign call on the VM's thread but got %v", threads)
+	}
+}
+
+func TestQueueReentrantCalls(t *testing.T) {
+	var order []string
+	var reentered error
+	module := NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static reenter()": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				if err := vm.InterpretString("main", `Log.add("interpreted")`); err != nil {
+					reentered = err
+					return nil, nil
+				}
+				if _, err := vm.CallStatic("main", "Log", "add(_)", "called"); err != nil {
+					return nil, err
+				}
+				if _, err := vm.Call("main", "Log", "add(_)", "not queued"); err != nil {
+					if _, ok := err.(*ReentrantCallError); !ok {
+						return nil, err
+					}
+				}
+				order = append(order, "returned")
+				return nil, nil
+			},
+		}),
+	})
+	source := `
+	class Host {
+		foreign static reenter()
+	}
+	class Log {
+		static add(entry) { (__entries = __entries || []).add(entry) }
+		static entries { __entries }
+	}
+	Host.reenter()
+	Log.add("done")`
+
+	vm := createConfig(t).NewVM()
+	vm.SetModule("main", module)
+	if err := vm.InterpretString("main", source); err != nil {
+		t.Fatal(err)
+	}
+	if !errors.As(reentered, new(*ReentrantCallError)) {
+		t.Errorf("Expected ReentrantCallError but got %v", reentered)
+	}
+	vm.Free()
+
+	cfg := createConfig(t)
+	cfg.QueueReentrantCalls = true
+	vm = cfg.NewVM()
+	defer vm.Free()
+	vm.SetModule("main", module)
+	if err := vm.InterpretString("main", source); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := vm.Call("main", "Log", "entries")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer vm.FreeAll(entries)
+	got, _ := entries.(*ListHandle).ToSlice(false)
+	if fmt.Sprint(order, got) != "[returned] [done interpreted called]" {
+		t.Errorf("Unexpected order %v %v", order, got)
+	}
+}
+
+func TestDefer(t *testing.T) {
+	vm := createConfig(t).NewVM()
+	defer vm.Free()
+	var order []string
+	vm.SetModule("main", NewModule(ClassMap{
+		"Host": NewClass(nil, nil, MethodMap{
+			"static later(_)": func(vm *VM, parameters []interface{}) (interface{}, error) {
+				fn, ok := parameters[1].(*FnHandle)
+				if !ok {
+					return nil, errors.New("expected a function")
+				}
+				copied, err := fn.Copy()
+				if err != nil {
+					return nil, err
+				}
+				vm.Defer(func(vm *VM) error {
+					defer copied.Free()
+					order = append(order, "deferred")
+					_, err := copied.Call()
+					return err
+				})
+				order = append(order, "returned")
+				return nil, nil
+			},
+		}),
+	}))
+	err := vm.InterpretString("main", `
+	class Host {
+		foreign static later(fn)
+	}
+	var calledBack = false
+	Host.later { calledBack = true }
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	order = append(order, "interpreted")
+	if calledBack, _ := vm.GetVariable("main", "calledBack"); calledBack != true {
+		t.Error("Expected the deferred callback to call back into Wren")
+	}
+	vm.Defer(func(vm *VM) error {
+		order = append(order, "now")
+		return nil
+	})
+	if fmt.Sprint(order) != "[returned deferred interpreted now]" {
+		t.Errorf("Unexpected order %v", order)
+	}
+}
@@ -0,0 +1,106 @@
+package wrentest
+
+import (
+	"fmt"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// AssertModule is the name scripts import the assert module by
+const AssertModule = "assert"
+
+// Failure is an assertion that failed in a script
+type Failure struct {
+	// Where the assertion was made
+	Module string
+	Line   int
+	// What went wrong
+	Message string
+}
+
+func (f Failure) String() string {
+	return fmt.Sprintf("[%v line %v] %v", f.Module, f.Line, f.Message)
+}
+
+// Assertions records the assertions that fail in the scripts of a VM
+type Assertions struct {
+	Failures []Failure
+}
+
+// Module creates the assert module, which gives scripts an `Assert` class:
+//
+//	import "assert" for Assert
+//	Assert.equal(1 + 1, 2)
+//	Assert.equal([1, 2], [1, 2])    // lists and maps are compared by their elements
+//	Assert.isTrue(list.isEmpty)
+//	Assert.throws { Fiber.abort("oops") }
+//	Assert.fail("should not get here")
+//
+// Since `true` and `false` are keywords in Wren, checking bools is done with `isTrue` and `isFalse`. Each method can also be given a message as its last argument. Failed assertions are recorded with the line that made them and don't stop the script, like `testing.T.Error`. `Runner` sets this module for every script it runs and reports the failures to the test
+func (a *Assertions) Module() *wren.Module {
+	module := wren.NewModule(wren.ClassMap{
+		"Assert": wren.NewClass(nil, nil, wren.MethodMap{
+			"static fail_(_)": func(vm *wren.VM, parameters []interface{}) (interface{}, error) {
+				failure := Failure{Message: fmt.Sprint(parameters[1])}
+				for _, frame := range vm.CallStack() {
+					if frame.Module != AssertModule {
+						failure.Module, failure.Line = frame.Module, frame.Line
+						break
+					}
+				}
+				a.Failures = append(a.Failures, failure)
+				return wren.Null, nil
+			},
+		}),
+	})
+	module.Source = `
+class Assert {
+	static equal(actual, expected) { equal(actual, expected, null) }
+	static equal(actual, expected, message) {
+		if (!same_(actual, expected)) fail_(message || "Expected %(expected) but got %(actual)")
+	}
+
+	static isTrue(value) { isTrue(value, null) }
+	static isTrue(value, message) {
+		if (value != true) fail_(message || "Expected true but got %(value)")
+	}
+
+	static isFalse(value) { isFalse(value, null) }
+	static isFalse(value, message) {
+		if (value != false) fail_(message || "Expected false but got %(value)")
+	}
+
+	static throws(fn) { throws(fn, null) }
+	static throws(fn, message) {
+		var fiber = Fiber.new(fn)
+		fiber.try()
+		if (fiber.error == null) fail_(message || "Expected an error to be thrown")
+		return fiber.error
+	}
+
+	static fail() { fail_("Failed") }
+	static fail(message) { fail_(message) }
+
+	static same_(a, b) {
+		if (a is List && b is List) {
+			if (a.count != b.count) return false
+			for (i in 0...a.count) {
+				if (!same_(a[i], b[i])) return false
+			}
+			return true
+		}
+		if (a is Map && b is Map) {
+			if (a.count != b.count) return false
+			for (key in a.keys) {
+				if (!b.containsKey(key) || !same_(a[key], b[key])) return false
+			}
+			return true
+		}
+		return a == b
+	}
+
+	foreign static fail_(message)
+}
+`
+	return module
+}
@@ -0,0 +1,128 @@
+// Package wrentest runs Wren scripts as Go tests. Each script's output is compared against a golden file next to it, the way Wren's own test suite checks its scripts:
+//
+//	func TestScripts(t *testing.T) {
+//		wrentest.Run(t, "testdata")
+//	}
+//
+// For "testdata/hello.wren", what the script prints (and every error Wren reports) must match "testdata/hello.expected".
+package wrentest
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+// Extension of the golden files that hold what a script should output
+const ExpectedExt = ".expected"
+
+// Runner runs the scripts in a directory as tests
+type Runner struct {
+	// Config used to create the VM for each script. Its `WriteFn`, `LineWriter` and `ErrorFn` are replaced to capture the output. If its `LoadModuleFn` is not set, modules are imported from the script's directory
+	Config *wren.Config
+	// If set, this is called with each script's VM before the script runs, such as to set the modules it uses
+	Setup func(vm *wren.VM)
+	// If true, golden files are written with what the scripts output instead of being compared against it. This is usually set from a flag so golden files can be updated with `go test -update`
+	Update bool
+}
+
+// Run runs every script in `dir` as a subtest of `t` with the default runner
+func Run(t *testing.T, dir string) {
+	(&Runner{}).Run(t, dir)
+}
+
+// Run runs every ".wren" file in `dir` and its subdirectories as a subtest of `t` named after the file's path. A script fails if its output doesn't match its golden file, if it doesn't have one, or if any of its assertions (see `Assertions.Module`) fail
+func (r *Runner) Run(t *testing.T, dir string) {
+	t.Helper()
+	var scripts []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() && filepath.Ext(path) == ".wren" {
+			scripts = append(scripts, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scripts) == 0 {
+		t.Fatalf("No scripts found in %v", dir)
+	}
+	for _, script := range scripts {
+		script := script
+		name, _ := filepath.Rel(dir, script)
+		t.Run(filepath.ToSlash(strings.TrimSuffix(name, ".wren")), func(t *testing.T) {
+			r.check(t, script)
+		})
+	}
+}
+
+func (r *Runner) check(t *testing.T, script string) {
+	got, failures, err := r.run(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, failure := range failures {
+		t.Error(failure)
+	}
+	golden := strings.TrimSuffix(script, ".wren") + ExpectedExt
+	if r.Update {
+		if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Could not read the golden file: %v", err)
+	}
+	if expected := strings.ReplaceAll(string(want), "\r\n", "\n"); got != expected {
+		t.Errorf("Output of %v does not match %v\n--- got ---\n%v--- expected ---\n%v", script, golden, got, expected)
+	}
+}
+
+// Output runs `script` in a new VM and returns what it printed and the errors Wren reported (one per line) in the order they happened. Fibers still waiting on timers or channels are run until they finish. The error is only set if the script couldn't be read
+func (r *Runner) Output(script string) (string, error) {
+	output, _, err := r.run(script)
+	return output, err
+}
+
+// run runs `script` and returns its output and the assertions that failed
+func (r *Runner) run(script string) (string, []Failure, error) {
+	source, err := os.ReadFile(script)
+	if err != nil {
+		return "", nil, err
+	}
+	cfg := &wren.Config{}
+	if r.Config != nil {
+		cfg = r.Config.Clone()
+	}
+	var output bytes.Buffer
+	cfg.LineWriter = nil
+	cfg.WriteFn = func(vm *wren.VM, text string) {
+		output.WriteString(text)
+	}
+	cfg.ErrorFn = func(vm *wren.VM, err error) {
+		output.WriteString(err.Error() + "\n")
+	}
+	if cfg.LoadModuleFn == nil {
+		cfg.LoadModuleFn = wren.SearchPathLoader(filepath.Dir(script))
+	}
+	vm := cfg.NewVM()
+	defer vm.Free()
+	assertions := &Assertions{}
+	vm.SetModule(AssertModule, assertions.Module())
+	if r.Setup != nil {
+		r.Setup(vm)
+	}
+	module := strings.TrimSuffix(filepath.Base(script), ".wren")
+	// Wren already reported what went wrong
+	if vm.InterpretReader(module, bytes.NewReader(source)) == nil {
+		vm.Run()
+	}
+	return output.String(), assertions.Failures, nil
+}
@@ -0,0 +1,61 @@
+package wrentest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	wren "github.com/crazyinfin8/WrenGo"
+)
+
+func TestRun(t *testing.T) {
+	runner := &Runner{Setup: func(vm *wren.VM) {
+		vm.SetModule("timer", wren.NewTimerModule())
+	}}
+	runner.Run(t, "testdata")
+}
+
+func TestUpdate(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "count.wren")
+	if err := os.WriteFile(script, []byte("for (i in 1..3) System.print(i)"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	(&Runner{Update: true}).Run(t, dir)
+	golden, err := os.ReadFile(filepath.Join(dir, "count"+ExpectedExt))
+	if err != nil || string(golden) != "1\n2\n3\n" {
+		t.Fatalf("Unexpected golden file %q (%v)", golden, err)
+	}
+	if output, _ := (&Runner{}).Output(script); output != string(golden) {
+		t.Errorf("Expected the output to match the golden file, got %q", output)
+	}
+}
+
+func TestAssertFailures(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "failing.wren")
+	source := `import "assert" for Assert
+Assert.equal([1, 2], [1, 3])
+Assert.isTrue(false, "custom message")
+Assert.throws {}
+Assert.fail()
+System.print("still running")
+`
+	if err := os.WriteFile(script, []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+	output, failures, err := (&Runner{}).run(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"[failing line 2] Expected [1, 3] but got [1, 2]",
+		"[failing line 3] custom message",
+		"[failing line 4] Expected an error to be thrown",
+		"[failing line 5] Failed",
+	}
+	if fmt.Sprint(failures) != fmt.Sprint(expected) || output != "still running\n" {
+		t.Errorf("Unexpected failures %v and output %q", failures, output)
+	}
+}